@@ -1,8 +1,12 @@
 package gollection
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
+	"math/rand"
 	"sort"
+	"strings"
 
 	"github.com/meteormin/gollection/pkg/maps"
 	"github.com/meteormin/gollection/pkg/slice"
@@ -99,12 +103,25 @@ type Collection[T interface{}] interface {
 	// i: The index of the element.
 	Each(fn func(v T, i int))
 
+	// EachUntil applies a function to each element of the collection until it returns false.
+	//
+	// fn: The function to apply to each element. Returning false stops the iteration.
+	EachUntil(fn func(v T) bool)
+
 	// Remove removes an element at the specified index.
 	//
 	// index: The index of the element to be removed.
 	// error: An error if the removal fails.
 	Remove(index int) error
 
+	// Clear removes all elements from the collection.
+	Clear()
+
+	// Truncate keeps only the first n elements of the collection.
+	//
+	// n: The number of elements to keep, clamped to the range [0, Count()].
+	Truncate(n int)
+
 	// Concat concatenates the items of type T into a single string.
 	//
 	// The function takes a variadic parameter `items` of type T, which represents
@@ -114,6 +131,23 @@ type Collection[T interface{}] interface {
 	// The function does not return any values.
 	Concat(items ...T)
 
+	// Prepend adds the items to the front of the collection, preserving their order.
+	//
+	// items: The items to be added.
+	Prepend(items ...T)
+
+	// InsertSlice returns a new collection with the items inserted at the given index.
+	//
+	// index: The position to insert at, clamped to the range [0, Count()].
+	// items: The items to be inserted.
+	InsertSlice(index int, items ...T) Collection[T]
+
+	// InsertE returns a new collection with the item inserted at the given index.
+	//
+	// index: The position to insert at, which must be in the range [0, Count()].
+	// Returns ErrIndexOutOfRange instead of panicking when the index is invalid.
+	InsertE(index int, item T) (Collection[T], error)
+
 	// Push adds an item to the collection.
 	//
 	// item: The item to be added.
@@ -163,6 +197,11 @@ type Collection[T interface{}] interface {
 	// The function returns a new Collection[T] that contains the elements in the specified range.
 	Slice(start, end int) Collection[T]
 
+	// SliceE behaves like Slice but validates the bounds first.
+	//
+	// It returns ErrIndexOutOfRange instead of panicking unless 0 <= start <= end <= Count().
+	SliceE(start, end int) (Collection[T], error)
+
 	// Reverse returns a new collection with the elements in reverse order.
 	//
 	// No parameters.
@@ -176,6 +215,55 @@ type Collection[T interface{}] interface {
 	//
 	// It returns a new sorted collection of the same type.
 	Sort(func(i, j int) bool) Collection[T]
+
+	// Shuffle returns a new collection with the elements in random order.
+	//
+	// r: The source of randomness; a seeded source gives reproducible results.
+	// Returns a new Collection[T], leaving the receiver unchanged.
+	Shuffle(r *rand.Rand) Collection[T]
+
+	// Contains reports whether any element of the collection satisfies fn.
+	//
+	// fn: The predicate applied to each element.
+	// Returns true if a matching element exists.
+	Contains(fn func(v T) bool) bool
+
+	// IndexOf returns the index of the first element that satisfies fn.
+	//
+	// fn: The predicate applied to each element.
+	// Returns the index of the first matching element, or -1 if none match.
+	IndexOf(fn func(v T) bool) int
+
+	// Every reports whether all elements of the collection satisfy fn.
+	//
+	// fn: The predicate applied to each element.
+	// Returns true for an empty collection.
+	Every(fn func(v T) bool) bool
+
+	// Some reports whether at least one element of the collection satisfies fn.
+	//
+	// fn: The predicate applied to each element.
+	// Returns false for an empty collection.
+	Some(fn func(v T) bool) bool
+
+	// Paginate returns a new collection holding the elements of the given page.
+	//
+	// page: The 1-based page number.
+	// perPage: The number of elements per page.
+	// Returns an empty collection when the page is out of range.
+	Paginate(page, perPage int) Collection[T]
+
+	// Tap calls fn with the collection and returns the collection unchanged.
+	//
+	// fn: The function to call, typically for inspection or logging.
+	// Returns the same collection.
+	Tap(fn func(c Collection[T])) Collection[T]
+
+	// Pipe passes the collection to fn and returns its result.
+	//
+	// fn: The transform to apply to the whole collection.
+	// Returns the collection produced by fn.
+	Pipe(fn func(c Collection[T]) Collection[T]) Collection[T]
 }
 
 // BaseCollection base collection struct
@@ -272,6 +360,15 @@ func (b *BaseCollection[T]) Each(fn func(v T, i int)) {
 	slice.Each(b.items, fn)
 }
 
+// EachUntil loop items in collection until fn returns false
+func (b *BaseCollection[T]) EachUntil(fn func(v T) bool) {
+	for _, v := range b.items {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
 // Remove item in collection
 func (b *BaseCollection[T]) Remove(index int) error {
 	if b.IsEmpty() {
@@ -282,11 +379,59 @@ func (b *BaseCollection[T]) Remove(index int) error {
 	return nil
 }
 
+// Clear items in collection
+func (b *BaseCollection[T]) Clear() {
+	b.items = slice.Clear(b.items)
+}
+
+// Truncate items in collection to the first n
+func (b *BaseCollection[T]) Truncate(n int) {
+	n = min(max(n, 0), b.Count())
+	clear(b.items[n:])
+	b.items = b.items[:n]
+}
+
 // Concat items in collection
 func (b *BaseCollection[T]) Concat(items ...T) {
 	b.items = slice.Concat(b.items, items)
 }
 
+// Prepend adds items to the front of the collection.
+//
+// items: the items to be added, kept in the given order.
+func (b *BaseCollection[T]) Prepend(items ...T) {
+	b.items = slice.InsertAt(b.items, 0, items...)
+}
+
+// InsertSlice returns a new Collection with the items inserted at the given index.
+//
+// Parameters:
+// - index: the insert position; out-of-range values are clamped to [0, Count()].
+// - items: the items to be inserted.
+//
+// Return type(s):
+// - Collection[T]: a new Collection containing the inserted items.
+func (b *BaseCollection[T]) InsertSlice(index int, items ...T) Collection[T] {
+	return NewCollection(slice.InsertAt(b.All(), index, items...))
+}
+
+// InsertE returns a new Collection with the item inserted at the given index.
+//
+// Parameters:
+// - index: the insert position, which must be in the range [0, Count()].
+// - item: the item to be inserted.
+//
+// Return type(s):
+// - Collection[T]: a new Collection containing the inserted item.
+// - error: ErrIndexOutOfRange if the index is invalid.
+func (b *BaseCollection[T]) InsertE(index int, item T) (Collection[T], error) {
+	if index < 0 || index > b.Count() {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return b.InsertSlice(index, item), nil
+}
+
 // Push adds an item to the collection.
 //
 // item: the item to be added to the collection.
@@ -373,6 +518,24 @@ func (b *BaseCollection[T]) Slice(start, end int) Collection[T] {
 	return NewCollection(slice.Slice(b.All(), start, end))
 }
 
+// SliceE returns a new Collection containing the elements from the start index to the end index (exclusive).
+//
+// Parameters:
+// - start: the starting index of the slice.
+// - end: the ending index of the slice.
+//
+// Return type(s):
+// - Collection[T]: a new Collection containing the sliced elements.
+// - error: ErrIndexOutOfRange unless 0 <= start <= end <= Count().
+func (b *BaseCollection[T]) SliceE(start, end int) (Collection[T], error) {
+	items, err := slice.SliceE(b.All(), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCollection(items), nil
+}
+
 // Reverse returns a new Collection with the elements in reverse order.
 //
 // No parameters.
@@ -393,6 +556,145 @@ func (b *BaseCollection[T]) Sort(fun func(i, j int) bool) Collection[T] {
 	return NewCollection(items)
 }
 
+// Shuffle returns a new Collection with the elements in random order.
+//
+// Randomness is drawn from r, so a seeded source gives reproducible results.
+// The receiver is not modified.
+func (b *BaseCollection[T]) Shuffle(r *rand.Rand) Collection[T] {
+	return NewCollection(slice.Shuffle(b.items, r))
+}
+
+// Contains reports whether any element of the collection satisfies the given predicate.
+//
+// fn is called for each element until one matches.
+func (b *BaseCollection[T]) Contains(fn func(v T) bool) bool {
+	return slice.ContainsFunc(b.items, fn)
+}
+
+// IndexOf returns the index of the first element satisfying the given predicate.
+//
+// It returns -1 if no element matches.
+func (b *BaseCollection[T]) IndexOf(fn func(v T) bool) int {
+	return slice.IndexFunc(b.items, fn)
+}
+
+// Every reports whether all elements satisfy the given predicate.
+//
+// It stops at the first element that does not match and is vacuously true for an empty collection.
+func (b *BaseCollection[T]) Every(fn func(v T) bool) bool {
+	return slice.Every(b.items, fn)
+}
+
+// Some reports whether at least one element satisfies the given predicate.
+//
+// It stops at the first matching element and is false for an empty collection.
+func (b *BaseCollection[T]) Some(fn func(v T) bool) bool {
+	return slice.Some(b.items, fn)
+}
+
+// Paginate returns a new Collection containing the elements of the given 1-based page.
+//
+// Parameters:
+// - page: the 1-based page number.
+// - perPage: the number of elements per page.
+//
+// Return type(s):
+// - Collection[T]: the elements of the page; the last page may be partial,
+// and an out-of-range page yields an empty collection.
+func (b *BaseCollection[T]) Paginate(page, perPage int) Collection[T] {
+	if page < 1 || perPage < 1 {
+		return NewCollection([]T{})
+	}
+
+	start := (page - 1) * perPage
+	if start >= b.Count() {
+		return NewCollection([]T{})
+	}
+
+	end := min(start+perPage, b.Count())
+	return NewCollection(slice.Slice(b.items, start, end))
+}
+
+// Tap calls the given function with the collection and returns the collection itself.
+//
+// It is useful for inspecting a collection in the middle of a chain.
+func (b *BaseCollection[T]) Tap(fn func(c Collection[T])) Collection[T] {
+	fn(b)
+	return b
+}
+
+// Pipe passes the collection to the given function and returns whatever it produces.
+//
+// It is useful for applying reusable transforms in a chain.
+func (b *BaseCollection[T]) Pipe(fn func(c Collection[T]) Collection[T]) Collection[T] {
+	return fn(b)
+}
+
+// MarshalJSON encodes the collection as a JSON array of its items.
+//
+// An empty collection is encoded as [] rather than null.
+func (b *BaseCollection[T]) MarshalJSON() ([]byte, error) {
+	if b.items == nil {
+		return json.Marshal([]T{})
+	}
+
+	return json.Marshal(b.items)
+}
+
+// UnmarshalJSON replaces the items of the collection with the decoded JSON array.
+func (b *BaseCollection[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	b.items = items
+	return nil
+}
+
+// String renders the collection in a readable form, e.g. Collection[1 2 3].
+func (b *BaseCollection[T]) String() string {
+	return fmt.Sprintf("Collection%v", b.items)
+}
+
+// Reduce folds the elements of a collection into a single accumulated value.
+//
+// The elements are visited left to right, threading the accumulator through fn.
+// If the collection is empty, init is returned unchanged.
+func Reduce[T interface{}, A interface{}](c Collection[T], init A, fn func(acc A, v T) A) A {
+	return slice.Reduce(c.All(), init, fn)
+}
+
+// Unique returns a new collection keeping the first occurrence of each element.
+//
+// The order of the remaining elements is preserved.
+func Unique[T comparable](c Collection[T]) Collection[T] {
+	return NewCollection(slice.Distinct(c.All()))
+}
+
+// UniqueFunc returns a new collection keeping the first element for each distinct key returned by keyFn.
+//
+// The order of the remaining elements is preserved.
+func UniqueFunc[T interface{}, K comparable](c Collection[T], keyFn func(v T) K) Collection[T] {
+	return NewCollection(slice.DistinctFunc(c.All(), keyFn))
+}
+
+// Reject returns a new collection without the elements that satisfy fn.
+//
+// The source collection is not modified.
+func Reject[T interface{}](c Collection[T], fn func(v T) bool) Collection[T] {
+	return c.Filter(func(v T, i int) bool {
+		return !fn(v)
+	})
+}
+
+// ConcatCollections returns a new collection holding the elements of a followed by the elements of b.
+//
+// Neither source collection is modified.
+func ConcatCollections[T interface{}](a, b Collection[T]) Collection[T] {
+	return NewCollection(slice.Concat(a.All(), b.All()))
+}
+
 // CollectionMap interface
 type CollectionMap[K comparable, V interface{}] interface {
 	// Items returns the map of key-value pairs stored in the CollectionMap.
@@ -408,6 +710,12 @@ type CollectionMap[K comparable, V interface{}] interface {
 	// Get returns the value associated with the given key in the CollectionMap.
 	Get(key K) V
 
+	// GetOk returns the value associated with the given key and whether the key exists.
+	GetOk(key K) (V, bool)
+
+	// Has returns true if the given key exists in the CollectionMap, false otherwise.
+	Has(key K) bool
+
 	// Copy returns a copy of the CollectionMap.
 	Copy() CollectionMap[K, V]
 
@@ -482,6 +790,26 @@ func (b *BaseCollectionMap[k, v]) Get(key k) v {
 	return b.items[key]
 }
 
+// GetOk retrieves the value associated with the given key from the BaseCollectionMap.
+//
+// Parameters:
+// - key: the key used to retrieve the value.
+//
+// Return type:
+// - v: the value associated with the given key, or the zero value if the key does not exist.
+// - bool: whether the key exists.
+func (b *BaseCollectionMap[k, v]) GetOk(key k) (v, bool) {
+	value, ok := b.items[key]
+	return value, ok
+}
+
+// Has reports whether the given key exists in the BaseCollectionMap.
+//
+// Returns a boolean value.
+func (b *BaseCollectionMap[k, v]) Has(key k) bool {
+	return maps.Has(b.items, key)
+}
+
 // Copy returns a copy of the BaseCollectionMap.
 //
 // It returns a CollectionMap of type CollectionMap[k, v] containing a copy of the key-value pairs in the BaseCollectionMap.
@@ -581,3 +909,73 @@ func (b *BaseCollectionMap[k, v]) Remove(key k) error {
 func (b *BaseCollectionMap[k, v]) Merge(merge map[k]v) CollectionMap[k, v] {
 	return NewCollectionMap(maps.Merge(b.All(), merge))
 }
+
+// MarshalJSON encodes the BaseCollectionMap as a JSON object of its key-value pairs.
+//
+// Keys must be encodable as JSON object keys, i.e. strings, integers,
+// or types implementing encoding.TextMarshaler.
+// An empty map is encoded as {} rather than null.
+func (b *BaseCollectionMap[k, v]) MarshalJSON() ([]byte, error) {
+	if b.items == nil {
+		return json.Marshal(map[k]v{})
+	}
+
+	return json.Marshal(b.items)
+}
+
+// UnmarshalJSON replaces the items of the BaseCollectionMap with the decoded JSON object.
+// A JSON null leaves the BaseCollectionMap empty but usable.
+//
+// Keys must be decodable from JSON object keys, i.e. strings, integers,
+// or types implementing encoding.TextUnmarshaler.
+func (b *BaseCollectionMap[k, v]) UnmarshalJSON(data []byte) error {
+	var items map[k]v
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	if items == nil {
+		items = make(map[k]v)
+	}
+
+	b.items = items
+	return nil
+}
+
+// String renders the BaseCollectionMap in a readable form, e.g. CollectionMap[a:1 b:2].
+//
+// Keys are printed in sorted order as done by the fmt package.
+func (b *BaseCollectionMap[k, v]) String() string {
+	return "CollectionMap" + strings.TrimPrefix(fmt.Sprint(b.items), "map")
+}
+
+// MapValues applies fn to each value of the CollectionMap and returns a new CollectionMap with the results.
+//
+// Unlike CollectionMap.Map, fn may change the value type.
+func MapValues[K comparable, V interface{}, E interface{}](c CollectionMap[K, V], fn func(value V) E) CollectionMap[K, E] {
+	mapped := maps.Map(c.All(), func(value V, key K) E {
+		return fn(value)
+	})
+
+	return NewCollectionMap(mapped)
+}
+
+// ValuesCollection returns a Collection holding the values of the CollectionMap.
+//
+// The keys are dropped and the order of the values is unspecified.
+func ValuesCollection[K comparable, V interface{}](c CollectionMap[K, V]) Collection[V] {
+	values := make([]V, 0, c.Count())
+	c.Each(func(value V, key K) {
+		values = append(values, value)
+	})
+
+	return NewCollection(values)
+}
+
+// SortedEach applies fn to each key-value pair of the CollectionMap in ascending key order.
+func SortedEach[K cmp.Ordered, V interface{}](c CollectionMap[K, V], fn func(k K, v V)) {
+	items := c.Items()
+	for _, key := range maps.SortedKeys(items) {
+		fn(key, items[key])
+	}
+}
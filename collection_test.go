@@ -1,8 +1,14 @@
 package gollection_test
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/meteormin/gollection"
+	"github.com/meteormin/gollection/pkg/slice"
 	"log"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -251,6 +257,14 @@ func TestBaseCollection_Reverse(t *testing.T) {
 	var collection = gollection.NewCollection(testData)
 
 	log.Print(collection.Reverse())
+
+	if !slices.Equal(collection.Items(), testData) {
+		t.Error("source collection mutated", collection.Items())
+	}
+
+	if reversed := collection.Reverse(); !slices.Equal(reversed.Items(), []int{3, 2, 1}) {
+		t.Error(reversed.Items())
+	}
 }
 
 func TestBaseCollection_Sort(t *testing.T) {
@@ -259,4 +273,574 @@ func TestBaseCollection_Sort(t *testing.T) {
 	log.Print(collection.Sort(func(i, j int) bool {
 		return i > j
 	}))
+
+	if !slices.Equal(collection.Items(), testData) {
+		t.Error("source collection mutated", collection.Items())
+	}
+}
+
+func TestBaseCollection_Contains(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	if !collection.Contains(func(v int) bool { return v == 2 }) {
+		t.Error("2 must be contained")
+	}
+
+	if collection.Contains(func(v int) bool { return v == 10 }) {
+		t.Error("10 must not be contained")
+	}
+}
+
+func TestBaseCollection_IndexOf(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	if i := collection.IndexOf(func(v int) bool { return v == 3 }); i != 2 {
+		t.Error(i)
+	}
+
+	if i := collection.IndexOf(func(v int) bool { return v == 10 }); i != -1 {
+		t.Error(i)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	sum := gollection.Reduce(collection, 0, func(acc int, v int) int {
+		return acc + v
+	})
+	if sum != 6 {
+		t.Error(sum)
+	}
+
+	joined := gollection.Reduce(gollection.NewCollection([]string{"a", "b", "c"}), "", func(acc string, v string) string {
+		return acc + v
+	})
+	if joined != "abc" {
+		t.Error(joined)
+	}
+
+	empty := gollection.Reduce(gollection.NewCollection([]int{}), 10, func(acc int, v int) int {
+		return acc + v
+	})
+	if empty != 10 {
+		t.Error(empty)
+	}
+}
+
+func TestBaseCollectionMap_GetOk(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"a": 1, "zero": 0})
+
+	if v, ok := collectionMap.GetOk("a"); !ok || v != 1 {
+		t.Error(v, ok)
+	}
+
+	if v, ok := collectionMap.GetOk("b"); ok || v != 0 {
+		t.Error(v, ok)
+	}
+
+	if v, ok := collectionMap.GetOk("zero"); !ok || v != 0 {
+		t.Error(v, ok)
+	}
+}
+
+func TestBaseCollectionMap_Has(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"a": 1})
+
+	if !collectionMap.Has("a") {
+		t.Error("a must exist")
+	}
+
+	if collectionMap.Has("b") {
+		t.Error("b must not exist")
+	}
+
+	if gollection.NewCollectionMap(map[string]int{}).Has("a") {
+		t.Error("empty map must not have keys")
+	}
+}
+
+func TestBaseCollection_JSON(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	var collection = gollection.NewCollection([]point{{1, 2}, {3, 4}})
+
+	data, err := json.Marshal(collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `[{"x":1,"y":2},{"x":3,"y":4}]` {
+		t.Error(string(data))
+	}
+
+	var decoded gollection.BaseCollection[point]
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Count() != 2 || decoded.Get(1) != (point{3, 4}) {
+		t.Error(decoded.Items())
+	}
+
+	data, err = json.Marshal(gollection.NewCollection([]point{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "[]" {
+		t.Error(string(data))
+	}
+
+	var zero gollection.BaseCollection[point]
+	data, err = json.Marshal(&zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "[]" {
+		t.Error(string(data))
+	}
+}
+
+func TestBaseCollectionMap_JSON(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"a": 1, "b": 2})
+
+	data, err := json.Marshal(collectionMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"a":1,"b":2}` {
+		t.Error(string(data))
+	}
+
+	var decoded gollection.BaseCollectionMap[string, int]
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Count() != 2 || decoded.Get("a") != 1 || decoded.Get("b") != 2 {
+		t.Error(decoded.Items())
+	}
+
+	var zero gollection.BaseCollectionMap[string, int]
+	data, err = json.Marshal(&zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "{}" {
+		t.Error(string(data))
+	}
+
+	if err = json.Unmarshal([]byte("null"), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Count() != 0 {
+		t.Error(decoded.Items())
+	}
+
+	decoded.Put("b", 2)
+	if decoded.Get("b") != 2 {
+		t.Error(decoded.Items())
+	}
+}
+
+func TestBaseCollection_String(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	if s := fmt.Sprint(collection); s != "Collection[1 2 3]" {
+		t.Error(s)
+	}
+
+	if s := gollection.NewCollection([]string{}).(fmt.Stringer).String(); s != "Collection[]" {
+		t.Error(s)
+	}
+}
+
+func TestBaseCollectionMap_String(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"b": 2, "a": 1})
+
+	if s := fmt.Sprint(collectionMap); s != "CollectionMap[a:1 b:2]" {
+		t.Error(s)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	var collection = gollection.NewCollection([]int{3, 1, 3, 2, 1})
+
+	unique := gollection.Unique(collection)
+	if !slices.Equal(unique.Items(), []int{3, 1, 2}) {
+		t.Error(unique.Items())
+	}
+
+	if collection.Count() != 5 {
+		t.Error("source collection mutated", collection.Items())
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	var collection = gollection.NewCollection([]string{"apple", "avocado", "banana", "cherry", "blueberry"})
+
+	unique := gollection.UniqueFunc(collection, func(v string) byte {
+		return v[0]
+	})
+	if !slices.Equal(unique.Items(), []string{"apple", "banana", "cherry"}) {
+		t.Error(unique.Items())
+	}
+}
+
+func TestBaseCollection_Paginate(t *testing.T) {
+	var collection = gollection.NewCollection([]int{1, 2, 3, 4, 5, 6, 7})
+
+	if page := collection.Paginate(1, 3); !slices.Equal(page.Items(), []int{1, 2, 3}) {
+		t.Error(page.Items())
+	}
+
+	if page := collection.Paginate(2, 3); !slices.Equal(page.Items(), []int{4, 5, 6}) {
+		t.Error(page.Items())
+	}
+
+	if page := collection.Paginate(3, 3); !slices.Equal(page.Items(), []int{7}) {
+		t.Error(page.Items())
+	}
+
+	if page := collection.Paginate(4, 3); !page.IsEmpty() {
+		t.Error(page.Items())
+	}
+
+	if page := collection.Paginate(0, 3); !page.IsEmpty() {
+		t.Error(page.Items())
+	}
+}
+
+func TestBaseCollection_Tap(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	calls := 0
+	tapped := collection.Tap(func(c gollection.Collection[int]) {
+		calls++
+		log.Print(c.Items())
+	})
+
+	if tapped != collection {
+		t.Error("tap must return the same instance")
+	}
+
+	if calls != 1 {
+		t.Error(calls)
+	}
+}
+
+func TestBaseCollection_Pipe(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	double := func(c gollection.Collection[int]) gollection.Collection[int] {
+		return c.Map(func(v int, i int) int {
+			return v * 2
+		})
+	}
+
+	piped := collection.Pipe(double)
+	if piped == collection {
+		t.Error("pipe must return the transformed collection")
+	}
+
+	if !slices.Equal(piped.Items(), []int{2, 4, 6}) {
+		t.Error(piped.Items())
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"a": 1, "b": 2})
+
+	mapped := gollection.MapValues(collectionMap, func(value int) string {
+		return fmt.Sprintf("#%d", value)
+	})
+
+	if mapped.Count() != 2 || mapped.Get("a") != "#1" || mapped.Get("b") != "#2" {
+		t.Error(mapped.Items())
+	}
+
+	if collectionMap.Get("a") != 1 {
+		t.Error("source map mutated", collectionMap.Items())
+	}
+}
+
+func TestValuesCollection(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	values := gollection.ValuesCollection(collectionMap)
+	if values.Count() != collectionMap.Count() {
+		t.Error(values.Items())
+	}
+
+	for _, v := range []int{1, 2, 3} {
+		if !values.Contains(func(item int) bool { return item == v }) {
+			t.Error("missing value", v)
+		}
+	}
+}
+
+func TestBaseCollection_Filter_Index(t *testing.T) {
+	var collection = gollection.NewCollection([]string{"a", "b", "c", "d"})
+
+	var indexes []int
+	filtered := collection.Filter(func(v string, i int) bool {
+		indexes = append(indexes, i)
+		return i%2 == 1
+	})
+
+	if !slices.Equal(indexes, []int{0, 1, 2, 3}) {
+		t.Error(indexes)
+	}
+
+	if !slices.Equal(filtered.Items(), []string{"b", "d"}) {
+		t.Error(filtered.Items())
+	}
+}
+
+func TestReject(t *testing.T) {
+	var collection = gollection.NewCollection([]int{1, 2, 3, 4})
+
+	rejected := gollection.Reject(collection, func(v int) bool {
+		return v%2 == 0
+	})
+
+	if !slices.Equal(rejected.Items(), []int{1, 3}) {
+		t.Error(rejected.Items())
+	}
+
+	if !slices.Equal(collection.Items(), []int{1, 2, 3, 4}) {
+		t.Error("source collection mutated", collection.Items())
+	}
+}
+
+func TestBaseCollection_EachUntil(t *testing.T) {
+	var collection = gollection.NewCollection([]int{1, 2, 3, 4, 5})
+
+	var visited []int
+	collection.EachUntil(func(v int) bool {
+		visited = append(visited, v)
+		return v != 3
+	})
+
+	if !slices.Equal(visited, []int{1, 2, 3}) {
+		t.Error(visited)
+	}
+}
+
+func TestBaseCollection_Every(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	if !gollection.NewCollection([]int{}).Every(positive) {
+		t.Error("every must be true for an empty collection")
+	}
+
+	if !gollection.NewCollection([]int{1, 2, 3}).Every(positive) {
+		t.Error("all elements match")
+	}
+
+	calls := 0
+	some := gollection.NewCollection([]int{1, -2, 3}).Every(func(v int) bool {
+		calls++
+		return positive(v)
+	})
+	if some || calls != 2 {
+		t.Error(some, calls)
+	}
+
+	if gollection.NewCollection([]int{-1, -2}).Every(positive) {
+		t.Error("no element matches")
+	}
+}
+
+func TestBaseCollection_Some(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	if gollection.NewCollection([]int{}).Some(positive) {
+		t.Error("some must be false for an empty collection")
+	}
+
+	if !gollection.NewCollection([]int{1, 2, 3}).Some(positive) {
+		t.Error("all elements match")
+	}
+
+	calls := 0
+	some := gollection.NewCollection([]int{-1, 2, 3}).Some(func(v int) bool {
+		calls++
+		return positive(v)
+	})
+	if !some || calls != 2 {
+		t.Error(some, calls)
+	}
+
+	if gollection.NewCollection([]int{-1, -2}).Some(positive) {
+		t.Error("no element matches")
+	}
+}
+
+func TestBaseCollection_Clear(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+	collection.Clear()
+
+	if collection.Count() != 0 || !collection.IsEmpty() {
+		t.Error(collection.Items())
+	}
+
+	collection.Add(4)
+	if !slices.Equal(collection.Items(), []int{4}) {
+		t.Error(collection.Items())
+	}
+}
+
+func TestBaseCollection_Truncate(t *testing.T) {
+	var collection = gollection.NewCollection([]int{1, 2, 3, 4, 5})
+
+	collection.Truncate(3)
+	if !slices.Equal(collection.Items(), []int{1, 2, 3}) {
+		t.Error(collection.Items())
+	}
+
+	collection.Truncate(10)
+	if !slices.Equal(collection.Items(), []int{1, 2, 3}) {
+		t.Error(collection.Items())
+	}
+
+	collection.Truncate(-1)
+	if !collection.IsEmpty() {
+		t.Error(collection.Items())
+	}
+}
+
+func TestBaseCollection_Truncate_ReleasesTail(t *testing.T) {
+	a, b, c := 1, 2, 3
+	var collection = gollection.NewCollection([]*int{&a, &b, &c})
+
+	collection.Truncate(1)
+
+	items := collection.Items()
+	if len(items) != 1 || items[0] != &a {
+		t.Error(items)
+	}
+
+	for i, p := range items[len(items):cap(items)] {
+		if p != nil {
+			t.Error("dropped element still referenced", i+len(items), *p)
+		}
+	}
+}
+
+func TestSortedEach(t *testing.T) {
+	var collectionMap = gollection.NewCollectionMap(map[string]int{"c": 3, "a": 1, "b": 2})
+
+	var keys []string
+	var values []int
+	gollection.SortedEach(collectionMap, func(k string, v int) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+
+	if !slices.Equal(keys, []string{"a", "b", "c"}) || !slices.Equal(values, []int{1, 2, 3}) {
+		t.Error(keys, values)
+	}
+}
+
+func TestConcatCollections(t *testing.T) {
+	a := gollection.NewCollection([]int{1, 2})
+	b := gollection.NewCollection([]int{3, 4})
+
+	concat := gollection.ConcatCollections(a, b)
+	if !slices.Equal(concat.Items(), []int{1, 2, 3, 4}) {
+		t.Error(concat.Items())
+	}
+
+	if a.Count() != 2 || b.Count() != 2 {
+		t.Error("source collections mutated")
+	}
+}
+
+func TestBaseCollection_Shuffle(t *testing.T) {
+	var collection = gollection.NewCollection([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	s1 := collection.Shuffle(rand.New(rand.NewSource(1)))
+	s2 := collection.Shuffle(rand.New(rand.NewSource(1)))
+	if !slices.Equal(s1.Items(), s2.Items()) {
+		t.Error(s1.Items(), s2.Items())
+	}
+
+	if !slices.Equal(collection.Items(), []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Error("source collection mutated", collection.Items())
+	}
+}
+
+func TestBaseCollection_Prepend(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+	collection.Prepend(-1, 0)
+
+	if !slices.Equal(collection.Items(), []int{-1, 0, 1, 2, 3}) {
+		t.Error(collection.Items())
+	}
+}
+
+func TestBaseCollection_InsertSlice(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	inserted := collection.InsertSlice(1, 10, 11)
+	if !slices.Equal(inserted.Items(), []int{1, 10, 11, 2, 3}) {
+		t.Error(inserted.Items())
+	}
+
+	if !slices.Equal(collection.Items(), testData) {
+		t.Error("source collection mutated", collection.Items())
+	}
+
+	if clamped := collection.InsertSlice(10, 4); !slices.Equal(clamped.Items(), []int{1, 2, 3, 4}) {
+		t.Error(clamped.Items())
+	}
+
+	if clamped := collection.InsertSlice(-1, 0); !slices.Equal(clamped.Items(), []int{0, 1, 2, 3}) {
+		t.Error(clamped.Items())
+	}
+}
+
+func TestBaseCollection_InsertE(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	for _, index := range []int{-1, 4} {
+		if _, err := collection.InsertE(index, 10); !errors.Is(err, gollection.ErrIndexOutOfRange) {
+			t.Error(index, err)
+		}
+	}
+
+	inserted, err := collection.InsertE(1, 10)
+	if err != nil || !slices.Equal(inserted.Items(), []int{1, 10, 2, 3}) {
+		t.Error(inserted, err)
+	}
+
+	appended, err := collection.InsertE(3, 4)
+	if err != nil || !slices.Equal(appended.Items(), []int{1, 2, 3, 4}) {
+		t.Error(appended, err)
+	}
+}
+
+func TestBaseCollection_SliceE(t *testing.T) {
+	var collection = gollection.NewCollection(testData)
+
+	for _, bounds := range [][2]int{{-1, 2}, {0, 4}, {2, 1}} {
+		_, err := collection.SliceE(bounds[0], bounds[1])
+		if !errors.Is(err, gollection.ErrIndexOutOfRange) || !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Error(bounds, err)
+		}
+	}
+
+	sliced, err := collection.SliceE(1, 3)
+	if err != nil || !slices.Equal(sliced.Items(), []int{2, 3}) {
+		t.Error(sliced, err)
+	}
 }
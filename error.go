@@ -1,7 +1,12 @@
 package gollection
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/meteormin/gollection/pkg/slice"
+)
 
 var (
-	ErrIsEmpty = errors.New("collection is empty")
+	ErrIsEmpty         = errors.New("collection is empty")
+	ErrIndexOutOfRange = slice.ErrIndexOutOfRange
 )
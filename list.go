@@ -0,0 +1,121 @@
+package gollection
+
+// Node is an element of a List.
+type Node[T interface{}] struct {
+	Value T
+
+	prev *Node[T]
+	next *Node[T]
+	list *List[T]
+}
+
+// Next returns the next node or nil if n is the last node.
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Prev returns the previous node or nil if n is the first node.
+func (n *Node[T]) Prev() *Node[T] {
+	return n.prev
+}
+
+// List is a doubly linked list.
+//
+// Nodes returned by the push methods can be removed in O(1).
+type List[T interface{}] struct {
+	front *Node[T]
+	back  *Node[T]
+	len   int
+}
+
+// NewList creates an empty List.
+func NewList[T interface{}]() *List[T] {
+	return &List[T]{}
+}
+
+// PushFront inserts a new node holding value at the front of the list and returns it.
+func (l *List[T]) PushFront(value T) *Node[T] {
+	node := &Node[T]{Value: value, next: l.front, list: l}
+
+	if l.front != nil {
+		l.front.prev = node
+	} else {
+		l.back = node
+	}
+
+	l.front = node
+	l.len++
+
+	return node
+}
+
+// PushBack inserts a new node holding value at the back of the list and returns it.
+func (l *List[T]) PushBack(value T) *Node[T] {
+	node := &Node[T]{Value: value, prev: l.back, list: l}
+
+	if l.back != nil {
+		l.back.next = node
+	} else {
+		l.front = node
+	}
+
+	l.back = node
+	l.len++
+
+	return node
+}
+
+// Remove unlinks node from the list and returns its value.
+//
+// Removing a node that does not belong to the list has no effect.
+func (l *List[T]) Remove(node *Node[T]) T {
+	if node.list != l {
+		return node.Value
+	}
+
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		l.front = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		l.back = node.prev
+	}
+
+	node.prev, node.next, node.list = nil, nil, nil
+	l.len--
+
+	return node.Value
+}
+
+// Front returns the first node or nil if the list is empty.
+func (l *List[T]) Front() *Node[T] {
+	return l.front
+}
+
+// Back returns the last node or nil if the list is empty.
+func (l *List[T]) Back() *Node[T] {
+	return l.back
+}
+
+// Len returns the number of nodes in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Each applies fn to each value from front to back.
+func (l *List[T]) Each(fn func(v T)) {
+	for node := l.front; node != nil; node = node.next {
+		fn(node.Value)
+	}
+}
+
+// EachReverse applies fn to each value from back to front.
+func (l *List[T]) EachReverse(fn func(v T)) {
+	for node := l.back; node != nil; node = node.prev {
+		fn(node.Value)
+	}
+}
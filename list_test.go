@@ -0,0 +1,73 @@
+package gollection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/meteormin/gollection"
+)
+
+func listValues(l *gollection.List[int]) []int {
+	var values []int
+	l.Each(func(v int) {
+		values = append(values, v)
+	})
+	return values
+}
+
+func TestList_Push(t *testing.T) {
+	l := gollection.NewList[int]()
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+
+	if l.Len() != 3 {
+		t.Error(l.Len())
+	}
+
+	if !slices.Equal(listValues(l), []int{1, 2, 3}) {
+		t.Error(listValues(l))
+	}
+
+	var reversed []int
+	l.EachReverse(func(v int) {
+		reversed = append(reversed, v)
+	})
+	if !slices.Equal(reversed, []int{3, 2, 1}) {
+		t.Error(reversed)
+	}
+
+	if l.Front().Value != 1 || l.Back().Value != 3 {
+		t.Error(l.Front().Value, l.Back().Value)
+	}
+}
+
+func TestList_Remove(t *testing.T) {
+	l := gollection.NewList[int]()
+	l.PushBack(1)
+	middle := l.PushBack(2)
+	l.PushBack(3)
+
+	if v := l.Remove(middle); v != 2 {
+		t.Error(v)
+	}
+
+	if l.Len() != 2 || !slices.Equal(listValues(l), []int{1, 3}) {
+		t.Error(listValues(l))
+	}
+
+	if l.Front().Next() != l.Back() || l.Back().Prev() != l.Front() {
+		t.Error("nodes not relinked")
+	}
+
+	l.Remove(middle)
+	if l.Len() != 2 {
+		t.Error("removing a detached node must have no effect", l.Len())
+	}
+
+	l.Remove(l.Front())
+	l.Remove(l.Back())
+	if l.Len() != 0 || l.Front() != nil || l.Back() != nil {
+		t.Error(l.Len())
+	}
+}
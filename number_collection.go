@@ -0,0 +1,62 @@
+package gollection
+
+import (
+	"slices"
+
+	"github.com/meteormin/gollection/pkg/constraints"
+	"github.com/meteormin/gollection/pkg/slice"
+)
+
+// NumberCollection is a Collection of numbers with aggregation methods.
+//
+// It embeds BaseCollection, so every Collection method is available as well.
+type NumberCollection[T constraints.Number] struct {
+	*BaseCollection[T]
+}
+
+// NewNumberCollection creates a NumberCollection from the given numbers.
+func NewNumberCollection[T constraints.Number](items []T) *NumberCollection[T] {
+	return &NumberCollection[T]{
+		BaseCollection: &BaseCollection[T]{
+			items: slice.Copy(items),
+		},
+	}
+}
+
+// Sum returns the sum of all elements.
+//
+// An empty collection sums to 0.
+func (n *NumberCollection[T]) Sum() T {
+	return slice.Sum(n.items)
+}
+
+// Average returns the arithmetic mean of all elements as a float64.
+//
+// An empty collection returns 0.
+func (n *NumberCollection[T]) Average() float64 {
+	return slice.Average(n.items)
+}
+
+// Min returns the smallest element.
+//
+// It returns ErrIsEmpty if the collection is empty.
+func (n *NumberCollection[T]) Min() (T, error) {
+	if n.IsEmpty() {
+		var zero T
+		return zero, ErrIsEmpty
+	}
+
+	return slices.Min(n.items), nil
+}
+
+// Max returns the largest element.
+//
+// It returns ErrIsEmpty if the collection is empty.
+func (n *NumberCollection[T]) Max() (T, error) {
+	if n.IsEmpty() {
+		var zero T
+		return zero, ErrIsEmpty
+	}
+
+	return slices.Max(n.items), nil
+}
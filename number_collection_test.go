@@ -0,0 +1,76 @@
+package gollection_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/meteormin/gollection"
+)
+
+func TestNumberCollection_Int(t *testing.T) {
+	var collection = gollection.NewNumberCollection([]int{3, 1, 4, 1, 5})
+
+	if sum := collection.Sum(); sum != 14 {
+		t.Error(sum)
+	}
+
+	if avg := collection.Average(); avg != 2.8 {
+		t.Error(avg)
+	}
+
+	if min, err := collection.Min(); err != nil || min != 1 {
+		t.Error(min, err)
+	}
+
+	if max, err := collection.Max(); err != nil || max != 5 {
+		t.Error(max, err)
+	}
+}
+
+func TestNumberCollection_Float(t *testing.T) {
+	var collection = gollection.NewNumberCollection([]float64{1.5, -2.5, 4})
+
+	if sum := collection.Sum(); sum != 3 {
+		t.Error(sum)
+	}
+
+	if avg := collection.Average(); avg != 1 {
+		t.Error(avg)
+	}
+
+	if min, err := collection.Min(); err != nil || min != -2.5 {
+		t.Error(min, err)
+	}
+
+	if max, err := collection.Max(); err != nil || max != 4 {
+		t.Error(max, err)
+	}
+}
+
+func TestNumberCollection_Empty(t *testing.T) {
+	var collection = gollection.NewNumberCollection([]int{})
+
+	if sum := collection.Sum(); sum != 0 {
+		t.Error(sum)
+	}
+
+	if avg := collection.Average(); avg != 0 {
+		t.Error(avg)
+	}
+
+	if _, err := collection.Min(); !errors.Is(err, gollection.ErrIsEmpty) {
+		t.Error(err)
+	}
+
+	if _, err := collection.Max(); !errors.Is(err, gollection.ErrIsEmpty) {
+		t.Error(err)
+	}
+}
+
+func TestNumberCollection_Collection(t *testing.T) {
+	var collection gollection.Collection[int] = gollection.NewNumberCollection([]int{1, 2, 3})
+
+	if collection.Count() != 3 {
+		t.Error(collection.Count())
+	}
+}
@@ -0,0 +1,69 @@
+package gollection
+
+import (
+	"fmt"
+	"slices"
+)
+
+// OrderedMap is a map that remembers the insertion order of its keys.
+type OrderedMap[K comparable, V interface{}] struct {
+	keys  []K
+	items map[K]V
+}
+
+// NewOrderedMap creates an empty OrderedMap.
+func NewOrderedMap[K comparable, V interface{}]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		keys:  make([]K, 0),
+		items: make(map[K]V),
+	}
+}
+
+// Put adds or updates a key-value pair.
+//
+// Updating an existing key keeps its original position.
+func (o *OrderedMap[K, V]) Put(key K, value V) {
+	if _, ok := o.items[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.items[key] = value
+}
+
+// Get returns the value associated with the given key and whether the key exists.
+func (o *OrderedMap[K, V]) Get(key K) (V, bool) {
+	value, ok := o.items[key]
+	return value, ok
+}
+
+// Remove deletes the key-value pair with the given key.
+//
+// It returns an error if the key does not exist.
+func (o *OrderedMap[K, V]) Remove(key K) error {
+	if _, ok := o.items[key]; !ok {
+		return fmt.Errorf("this map has not key: %v", key)
+	}
+
+	delete(o.items, key)
+	i := slices.Index(o.keys, key)
+	o.keys = slices.Delete(o.keys, i, i+1)
+
+	return nil
+}
+
+// Keys returns the keys in insertion order.
+func (o *OrderedMap[K, V]) Keys() []K {
+	return slices.Clone(o.keys)
+}
+
+// Each applies fn to each key-value pair in insertion order.
+func (o *OrderedMap[K, V]) Each(fn func(value V, key K)) {
+	for _, key := range o.keys {
+		fn(o.items[key], key)
+	}
+}
+
+// Len returns the number of key-value pairs.
+func (o *OrderedMap[K, V]) Len() int {
+	return len(o.keys)
+}
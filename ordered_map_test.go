@@ -0,0 +1,66 @@
+package gollection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/meteormin/gollection"
+)
+
+func TestOrderedMap_Put(t *testing.T) {
+	om := gollection.NewOrderedMap[string, int]()
+	om.Put("c", 3)
+	om.Put("a", 1)
+	om.Put("b", 2)
+	om.Put("a", 10)
+
+	if keys := om.Keys(); !slices.Equal(keys, []string{"c", "a", "b"}) {
+		t.Error(keys)
+	}
+
+	if v, ok := om.Get("a"); !ok || v != 10 {
+		t.Error(v, ok)
+	}
+
+	if _, ok := om.Get("z"); ok {
+		t.Error("z must not exist")
+	}
+}
+
+func TestOrderedMap_Remove(t *testing.T) {
+	om := gollection.NewOrderedMap[string, int]()
+	om.Put("c", 3)
+	om.Put("a", 1)
+	om.Put("b", 2)
+
+	if err := om.Remove("a"); err != nil {
+		t.Error(err)
+	}
+
+	if err := om.Remove("a"); err == nil {
+		t.Error("removing a missing key must fail")
+	}
+
+	if keys := om.Keys(); !slices.Equal(keys, []string{"c", "b"}) || om.Len() != 2 {
+		t.Error(keys)
+	}
+}
+
+func TestOrderedMap_Each(t *testing.T) {
+	om := gollection.NewOrderedMap[string, int]()
+	om.Put("c", 3)
+	om.Put("a", 1)
+	om.Put("b", 2)
+	om.Put("c", 30)
+
+	var keys []string
+	var values []int
+	om.Each(func(value int, key string) {
+		keys = append(keys, key)
+		values = append(values, value)
+	})
+
+	if !slices.Equal(keys, []string{"c", "a", "b"}) || !slices.Equal(values, []int{30, 1, 2}) {
+		t.Error(keys, values)
+	}
+}
@@ -0,0 +1,73 @@
+package constraints_test
+
+import (
+	"testing"
+
+	"github.com/meteormin/gollection/pkg/constraints"
+)
+
+func sum[T constraints.Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func abs[T constraints.Signed](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func mask[T constraints.Unsigned](v T) T {
+	return v & 0xF
+}
+
+func half[T constraints.Float](v T) T {
+	return v / 2
+}
+
+func isEven[T constraints.Integer](v T) bool {
+	return v%2 == 0
+}
+
+func maxOf[T constraints.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+type celsius float64
+
+func TestConstraints(t *testing.T) {
+	if rs := sum(1, 2, 3); rs != 6 {
+		t.Error(rs)
+	}
+
+	if rs := sum[celsius](1.5, 2.5); rs != 4 {
+		t.Error(rs)
+	}
+
+	if rs := abs(int8(-3)); rs != 3 {
+		t.Error(rs)
+	}
+
+	if rs := mask(uint16(0xFF)); rs != 0xF {
+		t.Error(rs)
+	}
+
+	if rs := half(float32(3)); rs != 1.5 {
+		t.Error(rs)
+	}
+
+	if !isEven(uint(4)) || isEven(int64(3)) {
+		t.Error("isEven")
+	}
+
+	if rs := maxOf("a", "b"); rs != "b" {
+		t.Error(rs)
+	}
+}
@@ -0,0 +1,385 @@
+package iterator
+
+import "github.com/meteormin/gollection/pkg/slice"
+
+// MapIterator lazily transforms the elements of a source iterator.
+type MapIterator[T interface{}, E interface{}] struct {
+	index  int
+	source Iterator[T]
+	fn     func(T) E
+}
+
+func (i *MapIterator[T, E]) Next() (*E, error) {
+	next, err := i.source.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := i.fn(*next)
+	i.index++
+	return &mapped, nil
+}
+
+func (i *MapIterator[T, E]) HasNext() bool {
+	return i.source.HasNext()
+}
+
+func (i *MapIterator[T, E]) GetNext() (*E, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming transformed element without advancing the iterator.
+// The transform function is applied again when the element is consumed by Next.
+func (i *MapIterator[T, E]) Peek() (*E, error) {
+	peek, err := i.source.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := i.fn(*peek)
+	return &mapped, nil
+}
+
+func (i *MapIterator[T, E]) GetIndex() int {
+	return i.index
+}
+
+// Map creates an Iterator applying fn to each element of it as it is consumed.
+func Map[T interface{}, E interface{}](it Iterator[T], fn func(T) E) Iterator[E] {
+	return &MapIterator[T, E]{
+		source: it,
+		fn:     fn,
+	}
+}
+
+// FilterIterator lazily skips the elements of a source iterator that do not match a predicate.
+type FilterIterator[T interface{}] struct {
+	index    int
+	source   Iterator[T]
+	fn       func(T) bool
+	next     T
+	buffered bool
+}
+
+func (i *FilterIterator[T]) Next() (*T, error) {
+	if i.HasNext() {
+		next := i.next
+		i.buffered = false
+		i.index++
+		return &next, nil
+	}
+
+	return nil, ErrNoNext
+}
+
+// HasNext advances the source past non-matching elements and reports whether a matching one remains.
+func (i *FilterIterator[T]) HasNext() bool {
+	if i.buffered {
+		return true
+	}
+
+	for i.source.HasNext() {
+		next, err := i.source.Next()
+		if err != nil {
+			return false
+		}
+
+		if i.fn(*next) {
+			i.next = *next
+			i.buffered = true
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *FilterIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming matching element without advancing the iterator.
+func (i *FilterIterator[T]) Peek() (*T, error) {
+	if i.HasNext() {
+		peek := i.next
+		return &peek, nil
+	}
+
+	return nil, ErrNoNext
+}
+
+func (i *FilterIterator[T]) GetIndex() int {
+	return i.index
+}
+
+// Filter creates an Iterator yielding only the elements of it that satisfy fn.
+func Filter[T interface{}](it Iterator[T], fn func(T) bool) Iterator[T] {
+	return &FilterIterator[T]{
+		source: it,
+		fn:     fn,
+	}
+}
+
+// ChainIterator yields the elements of several iterators one after another.
+type ChainIterator[T interface{}] struct {
+	index   int
+	current int
+	iters   []Iterator[T]
+}
+
+func (i *ChainIterator[T]) Next() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	next, err := i.iters[i.current].Next()
+	if err != nil {
+		return nil, err
+	}
+
+	i.index++
+	return next, nil
+}
+
+// HasNext skips exhausted iterators and reports whether any element remains.
+func (i *ChainIterator[T]) HasNext() bool {
+	for i.current < len(i.iters) {
+		if i.iters[i.current].HasNext() {
+			return true
+		}
+		i.current++
+	}
+
+	return false
+}
+
+func (i *ChainIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming element without advancing the iterator.
+func (i *ChainIterator[T]) Peek() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	return i.iters[i.current].Peek()
+}
+
+func (i *ChainIterator[T]) GetIndex() int {
+	return i.index
+}
+
+// Chain creates an Iterator yielding all elements of each given iterator in order.
+//
+// Empty or exhausted iterators are skipped.
+func Chain[T interface{}](iters ...Iterator[T]) Iterator[T] {
+	return &ChainIterator[T]{
+		iters: iters,
+	}
+}
+
+// ZipIterator advances two iterators in lockstep, yielding their elements as pairs.
+type ZipIterator[A interface{}, B interface{}] struct {
+	index int
+	a     Iterator[A]
+	b     Iterator[B]
+}
+
+func (i *ZipIterator[A, B]) Next() (*slice.Pair[A, B], error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	a, err := i.a.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := i.b.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	i.index++
+	return &slice.Pair[A, B]{First: *a, Second: *b}, nil
+}
+
+// HasNext reports whether both iterators have a next element.
+func (i *ZipIterator[A, B]) HasNext() bool {
+	return i.a.HasNext() && i.b.HasNext()
+}
+
+func (i *ZipIterator[A, B]) GetNext() (*slice.Pair[A, B], error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming pair without advancing either iterator.
+func (i *ZipIterator[A, B]) Peek() (*slice.Pair[A, B], error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	a, err := i.a.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := i.b.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	return &slice.Pair[A, B]{First: *a, Second: *b}, nil
+}
+
+func (i *ZipIterator[A, B]) GetIndex() int {
+	return i.index
+}
+
+// Zip creates an Iterator pairing the elements of a and b.
+//
+// It stops as soon as either iterator is exhausted.
+func Zip[A interface{}, B interface{}](a Iterator[A], b Iterator[B]) Iterator[slice.Pair[A, B]] {
+	return &ZipIterator[A, B]{
+		a: a,
+		b: b,
+	}
+}
+
+// TakeIterator yields at most a fixed number of elements from a source iterator.
+type TakeIterator[T interface{}] struct {
+	index  int
+	limit  int
+	source Iterator[T]
+}
+
+func (i *TakeIterator[T]) Next() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	next, err := i.source.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	i.index++
+	return next, nil
+}
+
+// HasNext reports false once the limit is reached, even if the source has more elements.
+func (i *TakeIterator[T]) HasNext() bool {
+	return i.index < i.limit && i.source.HasNext()
+}
+
+func (i *TakeIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming element without advancing the iterator.
+func (i *TakeIterator[T]) Peek() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	return i.source.Peek()
+}
+
+func (i *TakeIterator[T]) GetIndex() int {
+	return i.index
+}
+
+// Take creates an Iterator yielding at most n elements of it.
+func Take[T interface{}](it Iterator[T], n int) Iterator[T] {
+	return &TakeIterator[T]{
+		limit:  n,
+		source: it,
+	}
+}
+
+// SkipIterator discards a fixed number of leading elements of a source iterator.
+type SkipIterator[T interface{}] struct {
+	index  int
+	skip   int
+	source Iterator[T]
+}
+
+func (i *SkipIterator[T]) Next() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	next, err := i.source.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	i.index++
+	return next, nil
+}
+
+// HasNext discards the leading elements on first use and reports whether any element remains.
+func (i *SkipIterator[T]) HasNext() bool {
+	for ; i.skip > 0; i.skip-- {
+		if _, err := i.source.Next(); err != nil {
+			i.skip = 0
+			return false
+		}
+	}
+
+	return i.source.HasNext()
+}
+
+func (i *SkipIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming element without advancing the iterator.
+func (i *SkipIterator[T]) Peek() (*T, error) {
+	if !i.HasNext() {
+		return nil, ErrNoNext
+	}
+
+	return i.source.Peek()
+}
+
+func (i *SkipIterator[T]) GetIndex() int {
+	return i.index
+}
+
+// Skip creates an Iterator discarding the first n elements of it.
+func Skip[T interface{}](it Iterator[T], n int) Iterator[T] {
+	return &SkipIterator[T]{
+		skip:   n,
+		source: it,
+	}
+}
+
+// Pull adapts an Iterator to the pull-style contract of iter.Pull.
+//
+// next returns the next value and true, or the zero value and false once the
+// iterator is exhausted. After stop is called, next always returns false.
+func Pull[T interface{}](it Iterator[T]) (next func() (T, bool), stop func()) {
+	stopped := false
+
+	next = func() (T, bool) {
+		var zero T
+		if stopped || !it.HasNext() {
+			return zero, false
+		}
+
+		v, err := it.Next()
+		if err != nil {
+			return zero, false
+		}
+
+		return *v, true
+	}
+
+	stop = func() {
+		stopped = true
+	}
+
+	return next, stop
+}
@@ -0,0 +1,201 @@
+package iterator_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/meteormin/gollection/pkg/iterator"
+)
+
+func TestMap(t *testing.T) {
+	iter := iterator.Map(iterator.NewIterator([]int{1, 2, 3}), func(v int) string {
+		return fmt.Sprint(v * 10)
+	})
+
+	var values []string
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 3 || values[0] != "10" || values[2] != "30" {
+		t.Error(values)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	source := iterator.NewIterator([]int{1, 2, 3, 4, 5, 6, 7})
+	evens := iterator.Filter(source, func(v int) bool {
+		return v%2 == 0
+	})
+	iter := iterator.Map(evens, func(v int) int {
+		return v * v
+	})
+
+	var values []int
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 3 || values[0] != 4 || values[1] != 16 || values[2] != 36 {
+		t.Error(values)
+	}
+
+	if evens.HasNext() {
+		t.Error("filter must report no next after trailing non-matching elements")
+	}
+}
+
+func TestChain(t *testing.T) {
+	iter := iterator.Chain(
+		iterator.NewIterator([]int{1, 2}),
+		iterator.NewIterator([]int{}),
+		iterator.NewIterator([]int{3}),
+	)
+
+	var values []int
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Error(values)
+	}
+
+	if iter.GetIndex() != 3 {
+		t.Error(iter.GetIndex())
+	}
+
+	if _, err := iter.Next(); !errors.Is(err, iterator.ErrNoNext) {
+		t.Error(err)
+	}
+}
+
+func TestZip(t *testing.T) {
+	iter := iterator.Zip(
+		iterator.NewIterator([]string{"a", "b", "c"}),
+		iterator.NewIterator([]int{1, 2, 3}),
+	)
+
+	count := 0
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next.Second != count+1 {
+			t.Error(*next)
+		}
+		count++
+	}
+
+	if count != 3 {
+		t.Error(count)
+	}
+
+	left := iterator.NewIterator([]string{"a", "b", "c"})
+	iter = iterator.Zip(left, iterator.NewIterator([]int{1}))
+
+	count = 0
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next.First != "a" || next.Second != 1 {
+			t.Error(*next)
+		}
+		count++
+	}
+
+	if count != 1 {
+		t.Error(count)
+	}
+}
+
+func TestTake(t *testing.T) {
+	source := iterator.NewIterator([]int{1, 2, 3, 4, 5})
+	iter := iterator.Take(source, 2)
+
+	var values []int
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Error(values)
+	}
+
+	if !source.HasNext() {
+		t.Error("source must still have elements")
+	}
+
+	if _, err := iter.Next(); !errors.Is(err, iterator.ErrNoNext) {
+		t.Error(err)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	iter := iterator.Skip(iterator.NewIterator([]int{1, 2, 3, 4, 5}), 3)
+
+	var values []int
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 2 || values[0] != 4 || values[1] != 5 {
+		t.Error(values)
+	}
+
+	if iterator.Skip(iterator.NewIterator([]int{1, 2}), 5).HasNext() {
+		t.Error("skipping past the end must yield nothing")
+	}
+}
+
+func TestPull(t *testing.T) {
+	next, stop := iterator.Pull(iterator.NewIterator([]int{1, 2, 3}))
+	defer stop()
+
+	var values []int
+	for {
+		v, ok := next()
+		if !ok {
+			break
+		}
+		values = append(values, v)
+	}
+
+	if len(values) != 3 || values[0] != 1 || values[2] != 3 {
+		t.Error(values)
+	}
+
+	next, stop = iterator.Pull(iterator.NewIterator([]int{1, 2, 3}))
+	if v, ok := next(); !ok || v != 1 {
+		t.Error(v, ok)
+	}
+
+	stop()
+	if v, ok := next(); ok || v != 0 {
+		t.Error(v, ok)
+	}
+}
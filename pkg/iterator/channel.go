@@ -0,0 +1,66 @@
+package iterator
+
+// ChannelIterator iterates over the values received from a channel.
+//
+// It is single-pass and cannot be reset: values are consumed from the channel
+// as the iterator advances, and iteration ends once the channel is closed and drained.
+type ChannelIterator[T interface{}] struct {
+	index    int
+	ch       <-chan T
+	next     T
+	buffered bool
+}
+
+func (i *ChannelIterator[T]) Next() (*T, error) {
+	if i.HasNext() {
+		next := i.next
+		i.buffered = false
+		i.index++
+		return &next, nil
+	}
+
+	return nil, ErrNoNext
+}
+
+// HasNext reports whether another value is available.
+// It blocks until a value is received or the channel is closed.
+func (i *ChannelIterator[T]) HasNext() bool {
+	if i.buffered {
+		return true
+	}
+
+	next, ok := <-i.ch
+	if !ok {
+		return false
+	}
+
+	i.next = next
+	i.buffered = true
+	return true
+}
+
+func (i *ChannelIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming value without advancing the iterator.
+// It returns ErrNoNext when the channel is closed and drained.
+func (i *ChannelIterator[T]) Peek() (*T, error) {
+	if i.HasNext() {
+		peek := i.next
+		return &peek, nil
+	}
+
+	return nil, ErrNoNext
+}
+
+func (i *ChannelIterator[T]) GetIndex() int {
+	return i.index
+}
+
+// FromChannel creates a single-pass Iterator pulling values from ch.
+func FromChannel[T interface{}](ch <-chan T) Iterator[T] {
+	return &ChannelIterator[T]{
+		ch: ch,
+	}
+}
@@ -0,0 +1,64 @@
+package iterator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/meteormin/gollection/pkg/iterator"
+)
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+	}()
+
+	iter := iterator.FromChannel(ch)
+
+	var values []int
+	for iter.HasNext() {
+		next, err := iter.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, *next)
+	}
+
+	if len(values) != 3 || values[0] != 1 || values[2] != 3 {
+		t.Error(values)
+	}
+
+	if iter.GetIndex() != 3 {
+		t.Error(iter.GetIndex())
+	}
+
+	if _, err := iter.Next(); !errors.Is(err, iterator.ErrNoNext) {
+		t.Error(err)
+	}
+}
+
+func TestChannelIterator_Peek(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	iter := iterator.FromChannel(ch)
+
+	peek, err := iter.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := iter.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *peek != *next || *next != 1 {
+		t.Error(*peek, *next)
+	}
+}
@@ -0,0 +1,7 @@
+package iterator
+
+import "errors"
+
+var (
+	ErrNoNext = errors.New("has not next")
+)
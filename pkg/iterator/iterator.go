@@ -1,11 +1,10 @@
 package iterator
 
-import "errors"
-
 type Iterator[T interface{}] interface {
 	Next() (*T, error)
 	HasNext() bool
 	GetNext() (*T, error)
+	Peek() (*T, error)
 	GetIndex() int
 }
 
@@ -20,7 +19,7 @@ func (i *StructIterator[T]) Next() (*T, error) {
 		i.index++
 		return &next, nil
 	}
-	return nil, errors.New("has not next")
+	return nil, ErrNoNext
 }
 
 func (i *StructIterator[T]) HasNext() bool {
@@ -30,12 +29,20 @@ func (i *StructIterator[T]) HasNext() bool {
 	return false
 }
 
+// GetNext returns a copy of the upcoming element without advancing the index.
 func (i *StructIterator[T]) GetNext() (*T, error) {
+	return i.Peek()
+}
+
+// Peek returns the upcoming element without advancing the index.
+// It returns ErrNoNext when the iterator is exhausted.
+func (i *StructIterator[T]) Peek() (*T, error) {
 	if i.HasNext() {
-		return &i.values[i.index], nil
+		peek := i.values[i.index]
+		return &peek, nil
 	}
 
-	return nil, errors.New("has not next")
+	return nil, ErrNoNext
 }
 
 func (i *StructIterator[T]) GetIndex() int {
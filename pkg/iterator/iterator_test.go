@@ -1,6 +1,7 @@
 package iterator_test
 
 import (
+	"errors"
 	"log"
 	"testing"
 
@@ -18,3 +19,52 @@ func TestNewIterator(t *testing.T) {
 		log.Print(*next)
 	}
 }
+
+func TestStructIterator_Peek(t *testing.T) {
+	iter := iterator.NewIterator([]int{1, 2})
+
+	peek, err := iter.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iter.GetIndex() != 0 {
+		t.Error("peek must not advance", iter.GetIndex())
+	}
+
+	next, err := iter.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *peek != *next {
+		t.Error(*peek, *next)
+	}
+
+	if _, err = iter.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = iter.Peek(); !errors.Is(err, iterator.ErrNoNext) {
+		t.Error(err)
+	}
+}
+
+func TestStructIterator_Copy(t *testing.T) {
+	values := []int{1, 2}
+	iter := iterator.NewIterator(values)
+
+	getNext, err := iter.GetNext()
+	if err != nil {
+		t.Fatal(err)
+	}
+	*getNext = 100
+
+	next, err := iter.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	*next = 200
+
+	if values[0] != 1 {
+		t.Error("backing slice mutated", values)
+	}
+}
@@ -0,0 +1,42 @@
+//go:build go1.23
+
+package iterator
+
+import "iter"
+
+// Seq adapts an Iterator to the standard iter.Seq so it can be used with range.
+//
+// The underlying iterator is consumed as the sequence is ranged over.
+func Seq[T interface{}](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			next, err := it.Next()
+			if err != nil {
+				return
+			}
+
+			if !yield(*next) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 adapts an Iterator to the standard iter.Seq2, yielding the index and value of each element.
+//
+// The underlying iterator is consumed as the sequence is ranged over.
+func Seq2[T interface{}](it Iterator[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for it.HasNext() {
+			index := it.GetIndex()
+			next, err := it.Next()
+			if err != nil {
+				return
+			}
+
+			if !yield(index, *next) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+//go:build go1.23
+
+package iterator_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/meteormin/gollection/pkg/iterator"
+)
+
+func TestSeq(t *testing.T) {
+	var values []int
+	for v := range iterator.Seq(iterator.NewIterator([]int{1, 2, 3})) {
+		values = append(values, v)
+	}
+
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Error(values)
+	}
+
+	values = nil
+	for v := range iterator.Seq(iterator.NewIterator([]int{1, 2, 3})) {
+		if v == 2 {
+			break
+		}
+		values = append(values, v)
+	}
+
+	if !slices.Equal(values, []int{1}) {
+		t.Error(values)
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	var indexes []int
+	var values []string
+	for i, v := range iterator.Seq2(iterator.NewIterator([]string{"a", "b", "c"})) {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	if !slices.Equal(indexes, []int{0, 1, 2}) || !slices.Equal(values, []string{"a", "b", "c"}) {
+		t.Error(indexes, values)
+	}
+}
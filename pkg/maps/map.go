@@ -1,5 +1,10 @@
 package maps
 
+import (
+	"cmp"
+	"slices"
+)
+
 // Copy creates a copy of the input map.
 //
 // It takes a map m as input and returns a new map that is a copy of m.
@@ -164,3 +169,286 @@ func Clear[k comparable, v interface{}](m map[k]v) map[k]v {
 
 	return m
 }
+
+// Keys returns the keys of the given map.
+//
+// The order of the returned keys is unspecified.
+//
+// Parameters:
+// - m: The map to read keys from.
+//
+// Return:
+// - A slice of the map's keys.
+func Keys[k comparable, v interface{}](m map[k]v) []k {
+	keys := make([]k, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// SortedKeys returns the keys of the given map sorted in ascending order.
+//
+// Parameters:
+// - m: The map to read keys from.
+//
+// Return:
+// - A slice of the map's keys in ascending order.
+func SortedKeys[k cmp.Ordered, v interface{}](m map[k]v) []k {
+	keys := Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Entry is a single key-value pair of a map.
+type Entry[k comparable, v interface{}] struct {
+	Key   k
+	Value v
+}
+
+// Entries returns the key-value pairs of the given map as a slice.
+//
+// The order of the returned entries is unspecified.
+//
+// Parameters:
+// - m: The map to convert.
+//
+// Return:
+// - A slice of the map's entries.
+func Entries[k comparable, v interface{}](m map[k]v) []Entry[k, v] {
+	entries := make([]Entry[k, v], 0, len(m))
+	for key, value := range m {
+		entries = append(entries, Entry[k, v]{Key: key, Value: value})
+	}
+
+	return entries
+}
+
+// FromEntries builds a map from a slice of key-value pairs.
+//
+// Later entries overwrite earlier ones when keys are duplicated.
+//
+// Parameters:
+// - entries: The entries to convert.
+//
+// Return:
+// - A new map containing the entries.
+func FromEntries[k comparable, v interface{}](entries []Entry[k, v]) map[k]v {
+	m := make(map[k]v, len(entries))
+	for _, entry := range entries {
+		m[entry.Key] = entry.Value
+	}
+
+	return m
+}
+
+// GetOrDefault returns the value stored under key, or def if the key is missing.
+//
+// Parameters:
+// - m: The map to read from.
+// - key: The key to look up.
+// - def: The value returned when the key is missing.
+func GetOrDefault[k comparable, v interface{}](m map[k]v, key k, def v) v {
+	if value, ok := m[key]; ok {
+		return value
+	}
+
+	return def
+}
+
+// GetOrElse returns the value stored under key, or the result of fn if the key is missing.
+//
+// fn is only called when the key is missing.
+//
+// Parameters:
+// - m: The map to read from.
+// - key: The key to look up.
+// - fn: The function computing the value returned when the key is missing.
+func GetOrElse[k comparable, v interface{}](m map[k]v, key k, fn func() v) v {
+	if value, ok := m[key]; ok {
+		return value
+	}
+
+	return fn()
+}
+
+// FilterKeys returns a new map containing only the pairs whose key satisfies fn.
+//
+// Parameters:
+//   - m: The map to filter.
+//   - fn: The predicate applied to each key.
+//
+// Return type:
+//   - map[k]v: The filtered map.
+func FilterKeys[k comparable, v interface{}](m map[k]v, fn func(key k) bool) map[k]v {
+	filtered := make(map[k]v)
+
+	for key, value := range m {
+		if fn(key) {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
+
+// Reject returns a new map containing only the pairs whose value does not satisfy fn.
+//
+// It is the complement of Filter for value-only predicates.
+//
+// Parameters:
+//   - m: The map to filter.
+//   - fn: The predicate applied to each value.
+//
+// Return type:
+//   - map[k]v: The filtered map.
+func Reject[k comparable, v interface{}](m map[k]v, fn func(value v) bool) map[k]v {
+	rejected := make(map[k]v)
+
+	for key, value := range m {
+		if !fn(value) {
+			rejected[key] = value
+		}
+	}
+
+	return rejected
+}
+
+// Has reports whether key exists in the given map.
+func Has[k comparable, v interface{}](m map[k]v, key k) bool {
+	_, ok := m[key]
+
+	return ok
+}
+
+// HasValue reports whether value is stored under any key of the given map.
+//
+// The values are scanned linearly.
+func HasValue[k comparable, v comparable](m map[k]v, value v) bool {
+	for _, item := range m {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Pick returns a new map containing only the listed keys.
+//
+// Keys that do not exist in m are ignored.
+//
+// Parameters:
+// - m: The source map.
+// - keys: The keys to keep.
+func Pick[k comparable, v interface{}](m map[k]v, keys ...k) map[k]v {
+	picked := make(map[k]v, len(keys))
+
+	for _, key := range keys {
+		if value, ok := m[key]; ok {
+			picked[key] = value
+		}
+	}
+
+	return picked
+}
+
+// Omit returns a new map containing every pair except the listed keys.
+//
+// Keys that do not exist in m are ignored.
+//
+// Parameters:
+// - m: The source map.
+// - keys: The keys to drop.
+func Omit[k comparable, v interface{}](m map[k]v, keys ...k) map[k]v {
+	omitted := Copy(m)
+
+	for _, key := range keys {
+		delete(omitted, key)
+	}
+
+	return omitted
+}
+
+// CloneFunc creates a copy of the input map, cloning each value with cloneVal.
+//
+// Unlike Copy, which is shallow, CloneFunc can produce a deep copy when
+// values are slices, maps, or pointers.
+//
+// Parameters:
+// - m: The map to clone.
+// - cloneVal: The function returning a copy of a value.
+//
+// Return:
+// - The cloned map.
+func CloneFunc[k comparable, v interface{}](m map[k]v, cloneVal func(value v) v) map[k]v {
+	cloned := make(map[k]v, len(m))
+	for key, value := range m {
+		cloned[key] = cloneVal(value)
+	}
+
+	return cloned
+}
+
+// Update computes and stores the value for key from its current value.
+//
+// fn receives the current value and true if the key exists, or the zero
+// value and false otherwise, and its result is stored under key.
+//
+// Parameters:
+// - m: The map to modify.
+// - key: The key to update or insert.
+// - fn: The function computing the new value.
+//
+// Return:
+// - The modified map.
+func Update[k comparable, v interface{}](m map[k]v, key k, fn func(old v, existed bool) v) map[k]v {
+	old, existed := m[key]
+	m[key] = fn(old, existed)
+
+	return m
+}
+
+// Partition splits a map into two new maps based on a value predicate.
+//
+// Both returned maps are non-nil, even when empty.
+//
+// Parameters:
+//   - m: The map to split.
+//   - fn: The predicate applied to each value.
+//
+// Return type:
+//   - matched: The pairs whose value satisfies fn.
+//   - rest: The pairs whose value does not satisfy fn.
+func Partition[k comparable, v interface{}](m map[k]v, fn func(value v) bool) (matched map[k]v, rest map[k]v) {
+	matched = make(map[k]v)
+	rest = make(map[k]v)
+
+	for key, value := range m {
+		if fn(value) {
+			matched[key] = value
+		} else {
+			rest[key] = value
+		}
+	}
+
+	return matched, rest
+}
+
+// CountValues returns how many times each value appears in the given map.
+//
+// Parameters:
+// - m: The map to tally.
+//
+// Return:
+// - A map from each distinct value to its number of occurrences.
+func CountValues[k comparable, v comparable](m map[k]v) map[v]int {
+	counts := make(map[v]int)
+	for _, value := range m {
+		counts[value]++
+	}
+
+	return counts
+}
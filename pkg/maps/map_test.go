@@ -3,6 +3,8 @@ package maps_test
 import (
 	"github.com/meteormin/gollection/pkg/maps"
 	"log"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -101,3 +103,268 @@ func TestClear(t *testing.T) {
 	log.Print(clear)
 	log.Print(m)
 }
+
+func TestKeys(t *testing.T) {
+	m := make(map[string]int)
+	m["a"] = 1
+	m["b"] = 2
+
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Error(keys)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b", 10: "j"}
+	if keys := maps.SortedKeys(m); !slices.Equal(keys, []int{1, 2, 3, 10}) {
+		t.Error(keys)
+	}
+
+	m2 := map[string]int{"banana": 1, "apple": 2, "cherry": 3}
+	if keys := maps.SortedKeys(m2); !slices.Equal(keys, []string{"apple", "banana", "cherry"}) {
+		t.Error(keys)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	entries := maps.Entries(m)
+	if len(entries) != len(m) {
+		t.Error(entries)
+	}
+
+	for _, entry := range entries {
+		if m[entry.Key] != entry.Value {
+			t.Error(entry)
+		}
+	}
+
+	roundTrip := maps.FromEntries(entries)
+	if len(roundTrip) != len(m) {
+		t.Error(roundTrip)
+	}
+	for key, value := range m {
+		if roundTrip[key] != value {
+			t.Error(key, roundTrip[key])
+		}
+	}
+}
+
+func TestFromEntries(t *testing.T) {
+	m := maps.FromEntries([]maps.Entry[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "b", Value: 2},
+		{Key: "a", Value: 3},
+	})
+
+	if len(m) != 2 || m["a"] != 3 || m["b"] != 2 {
+		t.Error(m)
+	}
+}
+
+func TestGetOrDefault(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	if v := maps.GetOrDefault(m, "a", 10); v != 1 {
+		t.Error(v)
+	}
+
+	if v := maps.GetOrDefault(m, "b", 10); v != 10 {
+		t.Error(v)
+	}
+}
+
+func TestGetOrElse(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	called := false
+	v := maps.GetOrElse(m, "a", func() int {
+		called = true
+		return 10
+	})
+	if v != 1 || called {
+		t.Error(v, called)
+	}
+
+	v = maps.GetOrElse(m, "b", func() int {
+		called = true
+		return 10
+	})
+	if v != 10 || !called {
+		t.Error(v, called)
+	}
+}
+
+func TestFilterKeys(t *testing.T) {
+	m := map[string]int{"user.id": 1, "user.name": 2, "order.id": 3}
+
+	filtered := maps.FilterKeys(m, func(key string) bool {
+		return !strings.HasPrefix(key, "user.")
+	})
+	if len(filtered) != 1 || filtered["order.id"] != 3 {
+		t.Error(filtered)
+	}
+
+	if len(m) != 3 {
+		t.Error("input mutated", m)
+	}
+}
+
+func TestReject(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	isEven := func(value int) bool {
+		return value%2 == 0
+	}
+
+	rejected := maps.Reject(m, isEven)
+	filtered := maps.Filter(m, func(value int, key string) bool {
+		return isEven(value)
+	})
+
+	if len(rejected) != 2 || rejected["a"] != 1 || rejected["c"] != 3 {
+		t.Error(rejected)
+	}
+
+	if len(rejected)+len(filtered) != len(m) {
+		t.Error(rejected, filtered)
+	}
+	for key := range filtered {
+		if _, ok := rejected[key]; ok {
+			t.Error("reject is not the complement of filter", key)
+		}
+	}
+}
+
+func TestHas(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	if !maps.Has(m, "a") {
+		t.Error("a must exist")
+	}
+
+	if maps.Has(m, "b") {
+		t.Error("b must not exist")
+	}
+
+	var nilMap map[string]int
+	if maps.Has(nilMap, "a") {
+		t.Error("nil map must not have keys")
+	}
+}
+
+func TestHasValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	if !maps.HasValue(m, 2) {
+		t.Error("2 must exist")
+	}
+
+	if maps.HasValue(m, 3) {
+		t.Error("3 must not exist")
+	}
+
+	var nilMap map[string]int
+	if maps.HasValue(nilMap, 0) {
+		t.Error("nil map must not have values")
+	}
+}
+
+func TestPick(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	picked := maps.Pick(m, "a", "c", "z")
+	if len(picked) != 2 || picked["a"] != 1 || picked["c"] != 3 {
+		t.Error(picked)
+	}
+
+	if len(m) != 3 {
+		t.Error("input mutated", m)
+	}
+}
+
+func TestOmit(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	omitted := maps.Omit(m, "a", "z")
+	if len(omitted) != 2 || omitted["b"] != 2 || omitted["c"] != 3 {
+		t.Error(omitted)
+	}
+
+	if len(m) != 3 {
+		t.Error("input mutated", m)
+	}
+}
+
+func TestCloneFunc(t *testing.T) {
+	m := map[string][]int{"a": {1, 2}, "b": {3}}
+
+	cloned := maps.CloneFunc(m, slices.Clone[[]int])
+	cloned["a"][0] = 100
+
+	if m["a"][0] != 1 {
+		t.Error("original mutated", m)
+	}
+
+	if !slices.Equal(cloned["b"], []int{3}) {
+		t.Error(cloned)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	m := make(map[string]int)
+	increment := func(old int, existed bool) int {
+		if !existed {
+			return 1
+		}
+		return old + 1
+	}
+
+	maps.Update(m, "a", func(old int, existed bool) int {
+		if existed || old != 0 {
+			t.Error(old, existed)
+		}
+		return increment(old, existed)
+	})
+	if m["a"] != 1 {
+		t.Error(m)
+	}
+
+	maps.Update(m, "a", increment)
+	maps.Update(m, "a", increment)
+	if m["a"] != 3 {
+		t.Error(m)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(value int) bool {
+		return value%2 == 0
+	}
+
+	matched, rest := maps.Partition(map[string]int{"a": 2, "b": 4}, isEven)
+	if len(matched) != 2 || rest == nil || len(rest) != 0 {
+		t.Error(matched, rest)
+	}
+
+	matched, rest = maps.Partition(map[string]int{"a": 1, "b": 3}, isEven)
+	if matched == nil || len(matched) != 0 || len(rest) != 2 {
+		t.Error(matched, rest)
+	}
+
+	matched, rest = maps.Partition(map[string]int{"a": 1, "b": 2, "c": 3}, isEven)
+	if len(matched) != 1 || matched["b"] != 2 || len(rest) != 2 || rest["a"] != 1 || rest["c"] != 3 {
+		t.Error(matched, rest)
+	}
+}
+
+func TestCountValues(t *testing.T) {
+	m := map[string]string{"alice": "admin", "bob": "user", "carol": "admin", "dave": "guest"}
+
+	counts := maps.CountValues(m)
+	if len(counts) != 3 || counts["admin"] != 2 || counts["user"] != 1 || counts["guest"] != 1 {
+		t.Error(counts)
+	}
+}
@@ -0,0 +1,8 @@
+package slice
+
+import "errors"
+
+var (
+	ErrEmpty           = errors.New("slice is empty")
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
@@ -1,7 +1,13 @@
 package slice
 
 import (
+	"cmp"
 	"math"
+	"math/rand"
+	"slices"
+	"sync"
+
+	"github.com/meteormin/gollection/pkg/constraints"
 )
 
 // Copy creates a copy of the input slice.
@@ -111,10 +117,15 @@ func Except[T interface{}](s []T, fn func(v T, i int) bool) []T {
 //
 // Returns:
 // - chunkedSlice: a 2D slice containing the chunked sub-slices.
+// A non-positive chunkSize yields an empty result.
 func Chunk[T interface{}](s []T, chunkSize int, fn ...func(v []T, i int)) [][]T {
 	var chunkSlice []T
 
 	chunkedSlice := make([][]T, 0)
+	if chunkSize <= 0 {
+		return chunkedSlice
+	}
+
 	chunkedSize := int(math.Ceil(float64(len(s)) / float64(chunkSize)))
 
 	var callback func(v []T, i int)
@@ -123,13 +134,13 @@ func Chunk[T interface{}](s []T, chunkSize int, fn ...func(v []T, i int)) [][]T
 	}
 
 	for i := 0; i < chunkedSize; i++ {
-		if (i*chunkSize)+chunkSize <= (len(s) - 1) {
-			chunkSlice = s[(i * chunkSize) : (i*chunkSize)+chunkSize]
-		} else {
-			chunkSlice = s[(i * chunkSize):]
-		}
+		start := i * chunkSize
+		end := min(start+chunkSize, len(s))
+		chunkSlice = s[start:end]
 
-		callback(chunkSlice, i)
+		if callback != nil {
+			callback(chunkSlice, i)
+		}
 
 		chunkedSlice = append(chunkedSlice, chunkSlice)
 	}
@@ -289,7 +300,7 @@ func Merge[T interface{}](s1 []T, s2 ...[]T) []T {
 // It takes a slice s as input and returns a new empty slice of type T.
 func Clear[T interface{}](s []T) []T {
 	clear(s)
-	return s
+	return s[:0]
 }
 
 // Reverse reverses the elements of a slice.
@@ -324,3 +335,1012 @@ func Reverse[T interface{}](s []T) []T {
 func Slice[T interface{}](s []T, start int, end int) []T {
 	return s[start:end]
 }
+
+// Reduce folds a slice into a single accumulated value.
+//
+// The function walks the slice `s` from left to right, passing the current
+// accumulator and element to `fn` and threading its result into the next call.
+// If `s` is empty, `fn` is never called and `init` is returned unchanged.
+//
+// Parameters:
+//   - s: the input slice.
+//   - init: the initial accumulator value.
+//   - fn: the reducer that takes the accumulator and an element and returns the next accumulator.
+//
+// Returns:
+//   - A: the final accumulator value.
+func Reduce[T interface{}, A interface{}](s []T, init A, fn func(acc A, v T) A) A {
+	acc := init
+
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// GroupBy groups the elements of a slice into buckets keyed by keyFn.
+//
+// The slice is walked once and every element is appended to the bucket
+// chosen by keyFn, so elements keep their input order within a bucket.
+// An empty input yields an empty, non-nil map.
+//
+// Parameters:
+//   - s: the input slice.
+//   - keyFn: the function that derives the bucket key of an element.
+//
+// Returns:
+//   - map[K][]T: the grouped elements keyed by their derived key.
+func GroupBy[T interface{}, K comparable](s []T, keyFn func(v T) K) map[K][]T {
+	grouped := make(map[K][]T)
+
+	for _, v := range s {
+		key := keyFn(v)
+		grouped[key] = append(grouped[key], v)
+	}
+
+	return grouped
+}
+
+// Distinct returns a new slice containing only the first occurrence of each value.
+//
+// Unlike a compaction of adjacent duplicates, repeated values are removed
+// across the whole slice while the original order is preserved.
+// The input slice is not modified.
+//
+// Parameters:
+//   - s: the input slice.
+//
+// Returns:
+//   - []T: a new slice with duplicate values removed.
+func Distinct[T comparable](s []T) []T {
+	seen := make(map[T]struct{}, len(s))
+	distinct := make([]T, 0, len(s))
+
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		distinct = append(distinct, v)
+	}
+
+	return distinct
+}
+
+// DistinctFunc returns a new slice keeping the first element for each distinct key.
+//
+// Uniqueness is decided by the key returned from keyFn, so T itself does not
+// need to be comparable. The original order is preserved.
+//
+// Parameters:
+//   - s: the input slice.
+//   - keyFn: the function that derives the identity key of an element.
+//
+// Returns:
+//   - []T: a new slice with elements of duplicate keys removed.
+func DistinctFunc[T interface{}, K comparable](s []T, keyFn func(v T) K) []T {
+	seen := make(map[K]struct{}, len(s))
+	distinct := make([]T, 0, len(s))
+
+	for _, v := range s {
+		key := keyFn(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		distinct = append(distinct, v)
+	}
+
+	return distinct
+}
+
+// Take returns a new slice containing the first n elements of s.
+//
+// A negative n is treated as 0 and an n larger than the length of s
+// returns a copy of the whole slice.
+//
+// Parameters:
+//   - s: the input slice.
+//   - n: the number of elements to take.
+//
+// Returns:
+//   - []T: a new slice with at most n elements.
+func Take[T interface{}](s []T, n int) []T {
+	n = min(max(n, 0), len(s))
+
+	return Copy(s[:n])
+}
+
+// Drop returns a new slice containing every element of s after the first n.
+//
+// A negative n is treated as 0 and an n larger than the length of s
+// returns an empty slice.
+//
+// Parameters:
+//   - s: the input slice.
+//   - n: the number of elements to drop.
+//
+// Returns:
+//   - []T: a new slice without the first n elements.
+func Drop[T interface{}](s []T, n int) []T {
+	n = min(max(n, 0), len(s))
+
+	return Copy(s[n:])
+}
+
+// Intersect returns the distinct elements of a that are also present in b.
+//
+// The result keeps the order of first appearance in a.
+// Empty inputs yield an empty, non-nil slice.
+func Intersect[T comparable](a, b []T) []T {
+	inB := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		inB[v] = struct{}{}
+	}
+
+	seen := make(map[T]struct{})
+	intersected := make([]T, 0)
+
+	for _, v := range a {
+		if _, ok := inB[v]; !ok {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		intersected = append(intersected, v)
+	}
+
+	return intersected
+}
+
+// Union returns the distinct elements present in either a or b.
+//
+// The result keeps the order of first appearance in a, followed by
+// the elements that only appear in b.
+// Empty inputs yield an empty, non-nil slice.
+func Union[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	union := make([]T, 0, len(a)+len(b))
+
+	for _, s := range [][]T{a, b} {
+		for _, v := range s {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			union = append(union, v)
+		}
+	}
+
+	return union
+}
+
+// Difference returns the distinct elements of a that are not present in b.
+//
+// The result keeps the order of first appearance in a.
+// Empty inputs yield an empty, non-nil slice.
+func Difference[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+
+	difference := make([]T, 0)
+
+	for _, v := range a {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		difference = append(difference, v)
+	}
+
+	return difference
+}
+
+// Sum returns the sum of all elements in a numeric slice.
+//
+// An empty slice sums to 0.
+func Sum[T constraints.Number](s []T) T {
+	var sum T
+
+	for _, v := range s {
+		sum += v
+	}
+
+	return sum
+}
+
+// Average returns the arithmetic mean of a numeric slice.
+//
+// The computation is done in float64 to avoid integer truncation.
+// An empty slice returns 0.
+func Average[T constraints.Number](s []T) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, v := range s {
+		sum += float64(v)
+	}
+
+	return sum / float64(len(s))
+}
+
+// IndexFunc returns the index of the first element satisfying fn, or -1 if none do.
+func IndexFunc[T interface{}](s []T, fn func(v T) bool) int {
+	for i, v := range s {
+		if fn(v) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Find returns the first element satisfying fn.
+//
+// Parameters:
+//   - s: the input slice.
+//   - fn: the predicate used to match an element.
+//
+// Returns:
+//   - T: the first matching element, or the zero value of T if nothing matches.
+//   - bool: whether a matching element was found.
+func Find[T interface{}](s []T, fn func(v T) bool) (T, bool) {
+	if i := IndexFunc(s, fn); i != -1 {
+		return s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// LastIndexFunc returns the index of the last element satisfying fn, or -1 if none do.
+//
+// The slice is scanned from the end and scanning stops at the first match.
+func LastIndexFunc[T interface{}](s []T, fn func(v T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if fn(s[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindLast returns the last element satisfying fn.
+//
+// Parameters:
+//   - s: the input slice.
+//   - fn: the predicate used to match an element.
+//
+// Returns:
+//   - T: the last matching element, or the zero value of T if nothing matches.
+//   - bool: whether a matching element was found.
+func FindLast[T interface{}](s []T, fn func(v T) bool) (T, bool) {
+	if i := LastIndexFunc(s, fn); i != -1 {
+		return s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// Pair holds two values of possibly different types.
+type Pair[A interface{}, B interface{}] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two slices into a slice of pairs.
+//
+// Elements are paired by index. If the slices have different lengths,
+// the result is truncated to the length of the shorter input.
+//
+// Parameters:
+//   - a: the slice providing the first value of each pair.
+//   - b: the slice providing the second value of each pair.
+//
+// Returns:
+//   - []Pair[A, B]: the paired elements.
+func Zip[A interface{}, B interface{}](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	zipped := make([]Pair[A, B], n)
+
+	for i := 0; i < n; i++ {
+		zipped[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+
+	return zipped
+}
+
+// Unzip splits a slice of pairs into two slices.
+//
+// It is the inverse of Zip.
+//
+// Parameters:
+//   - pairs: the pairs to split.
+//
+// Returns:
+//   - []A: the first values of each pair.
+//   - []B: the second values of each pair.
+func Unzip[A interface{}, B interface{}](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i] = p.First
+		b[i] = p.Second
+	}
+
+	return a, b
+}
+
+// Rotate returns a new slice with the elements shifted left by n positions.
+//
+// Elements shifted off the front wrap around to the back. A negative n
+// rotates right, and n is taken modulo the length so any value is valid.
+// An empty slice returns an empty slice.
+//
+// Parameters:
+//   - s: the input slice.
+//   - n: the number of positions to rotate left.
+//
+// Returns:
+//   - []T: a new rotated slice.
+func Rotate[T interface{}](s []T, n int) []T {
+	rotated := make([]T, 0, len(s))
+	if len(s) == 0 {
+		return rotated
+	}
+
+	n = ((n % len(s)) + len(s)) % len(s)
+	rotated = append(rotated, s[n:]...)
+	rotated = append(rotated, s[:n]...)
+
+	return rotated
+}
+
+// Count returns the number of elements satisfying fn.
+//
+// Unlike Filter, no intermediate slice is allocated.
+func Count[T interface{}](s []T, fn func(v T) bool) int {
+	count := 0
+
+	for _, v := range s {
+		if fn(v) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CountBy returns the number of elements for each key derived by keyFn.
+//
+// An empty input yields an empty, non-nil map.
+func CountBy[T interface{}, K comparable](s []T, keyFn func(v T) K) map[K]int {
+	counts := make(map[K]int)
+
+	for _, v := range s {
+		counts[keyFn(v)]++
+	}
+
+	return counts
+}
+
+// Associate builds a map from a slice using fn to produce each key-value pair.
+//
+// When several elements produce the same key, later entries overwrite earlier ones.
+// An empty input yields an empty, non-nil map.
+func Associate[T interface{}, K comparable, V interface{}](s []T, fn func(v T) (K, V)) map[K]V {
+	associated := make(map[K]V, len(s))
+
+	for _, v := range s {
+		key, value := fn(v)
+		associated[key] = value
+	}
+
+	return associated
+}
+
+// KeyBy builds a map from a slice keyed by keyFn, holding the elements themselves.
+//
+// When several elements produce the same key, later entries overwrite earlier ones.
+func KeyBy[T interface{}, K comparable](s []T, keyFn func(v T) K) map[K]T {
+	return Associate(s, func(v T) (K, T) {
+		return keyFn(v), v
+	})
+}
+
+// ChunkEach calls fn for each chunk of s without materializing all chunks.
+//
+// Chunks hold size elements except the last, which holds the remainder.
+// Iteration stops at the first error returned by fn, and that error is returned.
+// A non-positive size processes no chunks.
+//
+// Parameters:
+//   - s: the input slice to be chunked.
+//   - size: the size of each chunk.
+//   - fn: the function called with each chunk.
+//
+// Returns:
+//   - error: the first error returned by fn, or nil.
+func ChunkEach[T interface{}](s []T, size int, fn func(chunk []T) error) error {
+	return ForEachChunk(s, size, func(_ int, chunk []T) error {
+		return fn(chunk)
+	})
+}
+
+// ParallelMap applies fn to each element of s using up to workers goroutines.
+//
+// The slice is split into contiguous parts, one per worker, and the results
+// are written in place so the output order matches the input order.
+// When workers <= 1 the mapping runs sequentially.
+//
+// Parameters:
+//   - s: the slice to be mapped.
+//   - workers: the maximum number of goroutines to use.
+//   - fn: the function applied to each element. It must be safe for concurrent use.
+//
+// Returns:
+//   - []E: the mapped elements in input order.
+func ParallelMap[T interface{}, E interface{}](s []T, workers int, fn func(v T) E) []E {
+	mapped := make([]E, len(s))
+
+	workers = min(workers, len(s))
+	if workers <= 1 {
+		for i, v := range s {
+			mapped[i] = fn(v)
+		}
+		return mapped
+	}
+
+	size := (len(s) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				mapped[i] = fn(s[i])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return mapped
+}
+
+// FirstE returns the first element of a slice.
+//
+// It behaves like First but returns ErrEmpty instead of panicking on an empty slice.
+func FirstE[T interface{}](s []T) (T, error) {
+	if len(s) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return First(s), nil
+}
+
+// LastE returns the last element of a slice.
+//
+// It behaves like Last but returns ErrEmpty instead of panicking on an empty slice.
+func LastE[T interface{}](s []T) (T, error) {
+	if len(s) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return Last(s), nil
+}
+
+// PopE removes and returns the last element of a slice.
+//
+// It behaves like Pop but returns ErrEmpty instead of panicking on an empty slice.
+//
+// Returns:
+// - out: the slice without the last element.
+// - pop: the last element of the slice.
+// - err: ErrEmpty if the slice is empty.
+func PopE[T interface{}](s []T) (out []T, pop T, err error) {
+	if len(s) == 0 {
+		return s, pop, ErrEmpty
+	}
+
+	out, pop = Pop(s)
+	return out, pop, nil
+}
+
+// DequeueE removes and returns the first element of a slice.
+//
+// It behaves like Dequeue but returns ErrEmpty instead of panicking on an empty slice.
+//
+// Returns:
+// - out: the slice without the first element.
+// - deq: the first element of the slice.
+// - err: ErrEmpty if the slice is empty.
+func DequeueE[T interface{}](s []T) (out []T, deq T, err error) {
+	if len(s) == 0 {
+		return s, deq, ErrEmpty
+	}
+
+	out, deq = Dequeue(s)
+	return out, deq, nil
+}
+
+// Shuffle returns a new slice with the elements of s in random order.
+//
+// The input slice is left intact. Randomness is drawn from r, so a seeded
+// source gives reproducible results.
+func Shuffle[T interface{}](s []T, r *rand.Rand) []T {
+	shuffled := Copy(s)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// Sample returns n elements picked at random from distinct positions of s.
+//
+// If n >= len(s), a shuffled copy of the whole slice is returned.
+// A non-positive n returns an empty slice. Randomness is drawn from r.
+func Sample[T interface{}](s []T, n int, r *rand.Rand) []T {
+	n = min(max(n, 0), len(s))
+
+	return Shuffle(s, r)[:n]
+}
+
+// Splice removes deleteCount elements at start and inserts the given elements in their place.
+//
+// start is clamped to [0, len(s)] and deleteCount to the number of elements
+// available after start, so out-of-range arguments never panic.
+// The input slice is not modified.
+//
+// Parameters:
+//   - s: the input slice.
+//   - start: the index at which to remove and insert elements.
+//   - deleteCount: the number of elements to remove.
+//   - insert: the elements to insert at start.
+//
+// Returns:
+//   - result: a new slice with the elements removed and inserted.
+//   - removed: a new slice holding the removed elements.
+func Splice[T interface{}](s []T, start, deleteCount int, insert ...T) (result []T, removed []T) {
+	start = min(max(start, 0), len(s))
+	end := start + min(max(deleteCount, 0), len(s)-start)
+
+	removed = Copy(s[start:end])
+
+	result = make([]T, 0, len(s)-len(removed)+len(insert))
+	result = append(result, s[:start]...)
+	result = append(result, insert...)
+	result = append(result, s[end:]...)
+
+	return result, removed
+}
+
+// InsertAt inserts the given values at index i and returns the updated slice.
+//
+// An index equal to len(s) appends the values. Indices out of range are
+// clamped to [0, len(s)] instead of panicking. Like append, the result may
+// share the backing array of s when it has enough capacity.
+//
+// Parameters:
+//   - s: the slice to insert into.
+//   - i: the index at which to insert.
+//   - vs: the values to insert.
+//
+// Returns:
+//   - []T: the updated slice.
+func InsertAt[T interface{}](s []T, i int, vs ...T) []T {
+	i = min(max(i, 0), len(s))
+
+	return slices.Insert(s, i, vs...)
+}
+
+// RemoveRange returns a new slice without the elements in the half-open range [start, end).
+//
+// start and end are clamped to [0, len(s)]; an empty or inverted range removes nothing.
+// The input slice is not modified.
+func RemoveRange[T interface{}](s []T, start, end int) []T {
+	start = min(max(start, 0), len(s))
+	end = min(max(end, start), len(s))
+
+	removed := make([]T, 0, len(s)-(end-start))
+	removed = append(removed, s[:start]...)
+	removed = append(removed, s[end:]...)
+
+	return removed
+}
+
+// RemoveValue returns a new slice without any occurrence of v.
+//
+// The input slice is not modified.
+func RemoveValue[T comparable](s []T, v T) []T {
+	removed := make([]T, 0, len(s))
+
+	for _, item := range s {
+		if item != v {
+			removed = append(removed, item)
+		}
+	}
+
+	return removed
+}
+
+// Fill returns a new slice of length n with every element set to value.
+//
+// A non-positive n returns an empty slice.
+func Fill[T interface{}](value T, n int) []T {
+	filled := make([]T, max(n, 0))
+	for i := range filled {
+		filled[i] = value
+	}
+
+	return filled
+}
+
+// Repeat returns a new slice holding pattern concatenated times times.
+//
+// A non-positive times returns an empty slice.
+func Repeat[T interface{}](pattern []T, times int) []T {
+	repeated := make([]T, 0, len(pattern)*max(times, 0))
+	for i := 0; i < times; i++ {
+		repeated = append(repeated, pattern...)
+	}
+
+	return repeated
+}
+
+// Range returns the integers from start up to, but not including, end, advancing by step.
+//
+// A negative step produces a descending range. If step is zero or its
+// direction cannot reach end, an empty slice is returned.
+//
+// Parameters:
+//   - start: the first value of the range.
+//   - end: the exclusive bound of the range.
+//   - step: the difference between consecutive values.
+//
+// Returns:
+//   - []int: the generated range.
+func Range(start, end, step int) []int {
+	r := make([]int, 0)
+
+	switch {
+	case step > 0:
+		for i := start; i < end; i += step {
+			r = append(r, i)
+		}
+	case step < 0:
+		for i := start; i > end; i += step {
+			r = append(r, i)
+		}
+	}
+
+	return r
+}
+
+// MinBy returns the element with the smallest key derived by keyFn, along with its index.
+//
+// When several elements share the smallest key, the first one wins.
+// An empty slice returns the zero value and -1.
+func MinBy[T interface{}, K cmp.Ordered](s []T, keyFn func(v T) K) (T, int) {
+	return selectBy(s, keyFn, func(a, b K) bool {
+		return a < b
+	})
+}
+
+// MaxBy returns the element with the largest key derived by keyFn, along with its index.
+//
+// When several elements share the largest key, the first one wins.
+// An empty slice returns the zero value and -1.
+func MaxBy[T interface{}, K cmp.Ordered](s []T, keyFn func(v T) K) (T, int) {
+	return selectBy(s, keyFn, func(a, b K) bool {
+		return a > b
+	})
+}
+
+func selectBy[T interface{}, K cmp.Ordered](s []T, keyFn func(v T) K, better func(a, b K) bool) (T, int) {
+	if len(s) == 0 {
+		var zero T
+		return zero, -1
+	}
+
+	index := 0
+	best := keyFn(s[0])
+	for i := 1; i < len(s); i++ {
+		if key := keyFn(s[i]); better(key, best) {
+			best = key
+			index = i
+		}
+	}
+
+	return s[index], index
+}
+
+// SortCopy returns a sorted copy of s in ascending order.
+//
+// The input slice is not modified.
+func SortCopy[T cmp.Ordered](s []T) []T {
+	sorted := Copy(s)
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// SortFuncCopy returns a copy of s sorted by the given comparison function.
+//
+// cmp should return a negative number when a < b, a positive number when
+// a > b and zero when a == b. The input slice is not modified.
+func SortFuncCopy[T interface{}](s []T, cmp func(a, b T) int) []T {
+	sorted := Copy(s)
+	slices.SortFunc(sorted, cmp)
+
+	return sorted
+}
+
+// ForEachChunk calls fn with each chunk of s and its zero-based chunk index.
+//
+// Chunks hold size elements except the last, which holds the remainder.
+// Iteration stops at the first error returned by fn, and that error is returned.
+// A non-positive size processes no chunks.
+//
+// Parameters:
+//   - s: the input slice to be chunked.
+//   - size: the size of each chunk.
+//   - fn: the function called with each chunk index and chunk.
+//
+// Returns:
+//   - error: the first error returned by fn, or nil.
+func ForEachChunk[T interface{}](s []T, size int, fn func(chunkIndex int, chunk []T) error) error {
+	if size <= 0 {
+		return nil
+	}
+
+	for i, start := 0, 0; start < len(s); i, start = i+1, start+size {
+		end := min(start+size, len(s))
+		if err := fn(i, s[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EqualUnordered reports whether a and b contain the same elements with the same
+// multiplicities, regardless of order.
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+
+	return true
+}
+
+// Frequency returns how many times each distinct value appears in s.
+//
+// An empty input yields an empty, non-nil map.
+func Frequency[T comparable](s []T) map[T]int {
+	frequency := make(map[T]int)
+	for _, v := range s {
+		frequency[v]++
+	}
+
+	return frequency
+}
+
+// Mode returns the most frequent element of s and its count.
+//
+// On ties, the element whose first occurrence comes earliest wins, so the
+// result is deterministic. An empty slice returns the zero value, 0 and false.
+func Mode[T comparable](s []T) (T, int, bool) {
+	var mode T
+	if len(s) == 0 {
+		return mode, 0, false
+	}
+
+	frequency := Frequency(s)
+
+	count := 0
+	for _, v := range s {
+		if frequency[v] > count {
+			mode = v
+			count = frequency[v]
+		}
+	}
+
+	return mode, count, true
+}
+
+// Every reports whether all elements of s satisfy fn.
+//
+// It stops at the first element that does not match and is vacuously true for an empty slice.
+func Every[T interface{}](s []T, fn func(v T) bool) bool {
+	for _, v := range s {
+		if !fn(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Some reports whether at least one element of s satisfies fn.
+//
+// It stops at the first matching element and is false for an empty slice.
+func Some[T interface{}](s []T, fn func(v T) bool) bool {
+	for _, v := range s {
+		if fn(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsFunc reports whether at least one element of s satisfies fn.
+func ContainsFunc[T interface{}](s []T, fn func(v T) bool) bool {
+	return IndexFunc(s, fn) != -1
+}
+
+// DeepCopyFunc returns a new slice holding a copy of each element made by cloneElem.
+//
+// Copy only copies the elements themselves, so elements that are pointers,
+// slices, or maps still share storage. DeepCopyFunc lets the caller decide
+// how each element is cloned.
+//
+// Parameters:
+//   - s: the slice to copy.
+//   - cloneElem: the function returning a copy of an element.
+//
+// Returns:
+//   - []T: the deep-copied slice.
+func DeepCopyFunc[T interface{}](s []T, cloneElem func(v T) T) []T {
+	copied := make([]T, len(s))
+	for i, v := range s {
+		copied[i] = cloneElem(v)
+	}
+
+	return copied
+}
+
+// DedupInPlace removes repeated values from s without allocating a new slice.
+//
+// Distinct first occurrences are compacted into the front of the existing
+// backing array, preserving their order, and the truncated slice is returned.
+// Unlike Distinct, this mutates the input.
+func DedupInPlace[T comparable](s []T) []T {
+	seen := make(map[T]struct{}, len(s))
+
+	n := 0
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		s[n] = v
+		n++
+	}
+
+	clear(s[n:])
+	return s[:n]
+}
+
+// ChunkBy splits a slice into chunks, starting a new chunk whenever boundary(prev, curr) returns true.
+//
+// prev and curr are adjacent elements of s. An empty slice yields no chunks.
+// The returned chunks share the backing array of s.
+//
+// Parameters:
+//   - s: the input slice to be chunked.
+//   - boundary: the predicate deciding whether curr starts a new chunk.
+//
+// Returns:
+//   - [][]T: the chunks in input order.
+func ChunkBy[T interface{}](s []T, boundary func(prev, curr T) bool) [][]T {
+	chunked := make([][]T, 0)
+	if len(s) == 0 {
+		return chunked
+	}
+
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if boundary(s[i-1], s[i]) {
+			chunked = append(chunked, s[start:i])
+			start = i
+		}
+	}
+
+	return append(chunked, s[start:])
+}
+
+// IndexAll returns the indices of every element equal to v, in order.
+//
+// An empty result is a non-nil empty slice.
+func IndexAll[T comparable](s []T, v T) []int {
+	return IndexAllFunc(s, func(item T) bool {
+		return item == v
+	})
+}
+
+// IndexAllFunc returns the indices of every element satisfying fn, in order.
+//
+// An empty result is a non-nil empty slice.
+func IndexAllFunc[T interface{}](s []T, fn func(v T) bool) []int {
+	indexes := make([]int, 0)
+	for i, v := range s {
+		if fn(v) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// Tap calls fn with the slice and returns the slice unchanged.
+//
+// It is useful for inspecting intermediate results in a chain of slice helpers.
+func Tap[T interface{}](s []T, fn func(s []T)) []T {
+	fn(s)
+
+	return s
+}
+
+// SliceE behaves like Slice but validates the bounds first.
+//
+// It returns ErrIndexOutOfRange instead of panicking unless 0 <= start <= end <= len(s).
+func SliceE[T interface{}](s []T, start int, end int) ([]T, error) {
+	if start < 0 || end < start || end > len(s) {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return Slice(s, start, end), nil
+}
+
+// RemoveE behaves like Remove but validates the index first.
+//
+// It returns ErrIndexOutOfRange instead of panicking unless 0 <= index < len(s).
+func RemoveE[T interface{}](s []T, index int) ([]T, error) {
+	if index < 0 || index >= len(s) {
+		return s, ErrIndexOutOfRange
+	}
+
+	return Remove(s, index), nil
+}
+
+// ReplaceE replaces the element at the given index with `v`.
+//
+// It returns ErrIndexOutOfRange instead of panicking unless 0 <= index < len(s).
+// On success the element is replaced in place and the updated slice is returned.
+func ReplaceE[T interface{}](s []T, index int, v T) ([]T, error) {
+	if index < 0 || index >= len(s) {
+		return s, ErrIndexOutOfRange
+	}
+
+	s[index] = v
+	return s, nil
+}
@@ -1,7 +1,11 @@
 package slice_test
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/meteormin/gollection/pkg/slice"
@@ -67,6 +71,10 @@ func TestExcept(t *testing.T) {
 	if rs[0] == 1 {
 		t.Error(rs[0])
 	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5}) {
+		t.Error("input mutated", testData)
+	}
 }
 
 func TestFilter(t *testing.T) {
@@ -158,6 +166,10 @@ func TestReverse(t *testing.T) {
 	if rs[0] != 5 {
 		t.Error(rs)
 	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5}) {
+		t.Error("input mutated", testData)
+	}
 }
 
 func TestMerge(t *testing.T) {
@@ -174,3 +186,1182 @@ func TestSlice(t *testing.T) {
 	rs := slice.Slice(testData, 0, 1)
 	log.Print(rs)
 }
+
+func TestReduce(t *testing.T) {
+	called := false
+	rs := slice.Reduce([]int{}, 10, func(acc int, v int) int {
+		called = true
+		return acc + v
+	})
+	if rs != 10 || called {
+		t.Error(rs, called)
+	}
+
+	rs = slice.Reduce([]int{5}, 1, func(acc int, v int) int {
+		return acc + v
+	})
+	if rs != 6 {
+		t.Error(rs)
+	}
+
+	set := slice.Reduce([]int{1, 2, 2, 3}, make(map[int]bool), func(acc map[int]bool, v int) map[int]bool {
+		acc[v] = true
+		return acc
+	})
+	if len(set) != 3 || !set[1] || !set[2] || !set[3] {
+		t.Error(set)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	rs := slice.GroupBy([]int{}, func(v int) int {
+		return v
+	})
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	grouped := slice.GroupBy([]int{1, 2, 3, 4, 5, 6}, func(v int) bool {
+		return v%2 == 0
+	})
+	if len(grouped) != 2 {
+		t.Error(grouped)
+	}
+	if !slices.Equal(grouped[true], []int{2, 4, 6}) || !slices.Equal(grouped[false], []int{1, 3, 5}) {
+		t.Error(grouped)
+	}
+
+	single := slice.GroupBy([]string{"b", "a", "c"}, func(v string) string {
+		return "all"
+	})
+	if len(single) != 1 || !slices.Equal(single["all"], []string{"b", "a", "c"}) {
+		t.Error(single)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	testData := []int{1, 2, 1, 3, 2}
+	rs := slice.Distinct(testData)
+
+	if !slices.Equal(rs, []int{1, 2, 3}) {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 1, 3, 2}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestDistinctFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	testData := []user{
+		{ID: 1, Name: "a"},
+		{ID: 2, Name: "b"},
+		{ID: 1, Name: "c"},
+		{ID: 3, Name: "d"},
+		{ID: 2, Name: "e"},
+	}
+
+	rs := slice.DistinctFunc(testData, func(v user) int {
+		return v.ID
+	})
+
+	if len(rs) != 3 {
+		t.Fatal(rs)
+	}
+
+	if rs[0].Name != "a" || rs[1].Name != "b" || rs[2].Name != "d" {
+		t.Error(rs)
+	}
+}
+
+func TestChunk_WithoutCallback(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+	rs := slice.Chunk(testData, 2)
+
+	if len(rs) != 3 {
+		t.Fatal(rs)
+	}
+
+	if !slices.Equal(rs[0], []int{1, 2}) || !slices.Equal(rs[1], []int{3, 4}) || !slices.Equal(rs[2], []int{5}) {
+		t.Error(rs)
+	}
+}
+
+func TestChunk_Remainder(t *testing.T) {
+	cases := []struct {
+		data      []int
+		chunkSize int
+		expected  [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}},
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2, 3, 4}, 4, [][]int{{1, 2, 3, 4}}},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+	}
+
+	for _, c := range cases {
+		rs := slice.Chunk(c.data, c.chunkSize)
+		if len(rs) != len(c.expected) {
+			t.Errorf("len(%v) = %d, want %d", c.data, len(rs), len(c.expected))
+			continue
+		}
+
+		for i, chunk := range rs {
+			if !slices.Equal(chunk, c.expected[i]) {
+				t.Errorf("chunk %d = %v, want %v", i, chunk, c.expected[i])
+			}
+		}
+	}
+}
+
+func TestChunk_NonPositiveSize(t *testing.T) {
+	testData := []int{1, 2, 3}
+
+	for _, size := range []int{0, -1} {
+		rs := slice.Chunk(testData, size, func(v []int, i int) {
+			t.Error("callback must not be called", v, i)
+		})
+		if rs == nil || len(rs) != 0 {
+			t.Error(size, rs)
+		}
+	}
+}
+
+func TestTake(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	if rs := slice.Take(testData, 2); !slices.Equal(rs, []int{1, 2}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Take(testData, -1); len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Take(testData, 10); !slices.Equal(rs, testData) {
+		t.Error(rs)
+	}
+
+	rs := slice.Take(testData, 2)
+	rs[0] = 100
+	if testData[0] != 1 {
+		t.Error("take must return a new slice")
+	}
+}
+
+func TestDrop(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	if rs := slice.Drop(testData, 2); !slices.Equal(rs, []int{3, 4, 5}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Drop(testData, -1); !slices.Equal(rs, testData) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Drop(testData, 10); len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	rs := slice.Drop(testData, 4)
+	rs[0] = 100
+	if testData[4] != 5 {
+		t.Error("drop must return a new slice")
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	rs := slice.Intersect([]string{"a", "b", "c", "b"}, []string{"c", "b", "d"})
+	if !slices.Equal(rs, []string{"b", "c"}) {
+		t.Error(rs)
+	}
+
+	rs = slice.Intersect([]string{"a"}, []string{"b"})
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	rs = slice.Intersect([]string{}, nil)
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	rs := slice.Union([]string{"a", "b", "a"}, []string{"c", "b", "d", "d"})
+	if !slices.Equal(rs, []string{"a", "b", "c", "d"}) {
+		t.Error(rs)
+	}
+
+	rs = slice.Union([]string{"a"}, []string{"b"})
+	if !slices.Equal(rs, []string{"a", "b"}) {
+		t.Error(rs)
+	}
+
+	rs = slice.Union([]string{}, nil)
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	rs := slice.Difference([]string{"a", "b", "c", "a", "d"}, []string{"b"})
+	if !slices.Equal(rs, []string{"a", "c", "d"}) {
+		t.Error(rs)
+	}
+
+	rs = slice.Difference([]string{"a"}, []string{"b"})
+	if !slices.Equal(rs, []string{"a"}) {
+		t.Error(rs)
+	}
+
+	rs = slice.Difference([]string{}, nil)
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if rs := slice.Sum([]int{1, 2, 3, 4}); rs != 10 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Sum([]float64{1.5, 2.5}); rs != 4 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Sum([]int{}); rs != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	if rs := slice.Average([]int{1, 2}); rs != 1.5 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Average([]float64{1.5, 2.5, 5}); rs != 3 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Average([]int{}); rs != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	testData := []int{1, 2, 3, 2}
+
+	if rs := slice.IndexFunc(testData, func(v int) bool { return v == 2 }); rs != 1 {
+		t.Error(rs)
+	}
+
+	if rs := slice.IndexFunc(testData, func(v int) bool { return v == 5 }); rs != -1 {
+		t.Error(rs)
+	}
+}
+
+func TestFind(t *testing.T) {
+	testData := []string{"apple", "banana", "cherry"}
+
+	v, ok := slice.Find(testData, func(v string) bool {
+		return v[0] == 'b'
+	})
+	if !ok || v != "banana" {
+		t.Error(v, ok)
+	}
+
+	v, ok = slice.Find(testData, func(v string) bool {
+		return v == "durian"
+	})
+	if ok || v != "" {
+		t.Error(v, ok)
+	}
+}
+
+func TestLastIndexFunc(t *testing.T) {
+	testData := []int{1, 2, 3, 2, 1}
+
+	if rs := slice.LastIndexFunc(testData, func(v int) bool { return v == 2 }); rs != 3 {
+		t.Error(rs)
+	}
+
+	if rs := slice.LastIndexFunc(testData, func(v int) bool { return v == 5 }); rs != -1 {
+		t.Error(rs)
+	}
+}
+
+func TestFindLast(t *testing.T) {
+	type item struct {
+		Kind string
+		ID   int
+	}
+
+	testData := []item{{"a", 1}, {"b", 2}, {"a", 3}, {"b", 4}}
+
+	v, ok := slice.FindLast(testData, func(v item) bool {
+		return v.Kind == "a"
+	})
+	if !ok || v.ID != 3 {
+		t.Error(v, ok)
+	}
+
+	v, ok = slice.FindLast(testData, func(v item) bool {
+		return v.Kind == "c"
+	})
+	if ok || v != (item{}) {
+		t.Error(v, ok)
+	}
+}
+
+func TestMap_Index(t *testing.T) {
+	testData := []string{"a", "b", "c"}
+	rs := slice.Map(testData, func(v string, i int) string {
+		return fmt.Sprintf("%d: %s", i, v)
+	})
+
+	if !slices.Equal(rs, []string{"0: a", "1: b", "2: c"}) {
+		t.Error(rs)
+	}
+}
+
+func TestFilter_Index(t *testing.T) {
+	var indexes []int
+	testData := []string{"a", "b", "c", "d"}
+	rs := slice.Filter(testData, func(v string, i int) bool {
+		indexes = append(indexes, i)
+		return i%2 == 0
+	})
+
+	if !slices.Equal(indexes, []int{0, 1, 2, 3}) {
+		t.Error(indexes)
+	}
+
+	if !slices.Equal(rs, []string{"a", "c"}) {
+		t.Error(rs)
+	}
+}
+
+func TestZip(t *testing.T) {
+	keys := []string{"a", "b", "c"}
+	values := []int{1, 2, 3}
+
+	rs := slice.Zip(keys, values)
+	if len(rs) != 3 || rs[1].First != "b" || rs[1].Second != 2 {
+		t.Error(rs)
+	}
+
+	rs = slice.Zip(keys, values[:2])
+	if len(rs) != 2 {
+		t.Error(rs)
+	}
+
+	rs = slice.Zip(keys[:1], values)
+	if len(rs) != 1 || rs[0].First != "a" || rs[0].Second != 1 {
+		t.Error(rs)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	keys := []string{"a", "b", "c"}
+	values := []int{1, 2, 3}
+
+	a, b := slice.Unzip(slice.Zip(keys, values))
+	if !slices.Equal(a, keys) || !slices.Equal(b, values) {
+		t.Error(a, b)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	if rs := slice.Rotate(testData, 2); !slices.Equal(rs, []int{3, 4, 5, 1, 2}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Rotate(testData, -1); !slices.Equal(rs, []int{5, 1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Rotate(testData, 12); !slices.Equal(rs, []int{3, 4, 5, 1, 2}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Rotate([]int{}, 3); len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestCount(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	if rs := slice.Count(testData, func(v int) bool { return v%2 == 0 }); rs != 2 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Count(testData, func(v int) bool { return v > 0 }); rs != 5 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Count([]int{}, func(v int) bool { return true }); rs != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	testData := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+
+	rs := slice.CountBy(testData, func(v string) byte {
+		return v[0]
+	})
+	if len(rs) != 3 || rs['a'] != 2 || rs['b'] != 2 || rs['c'] != 1 {
+		t.Error(rs)
+	}
+
+	empty := slice.CountBy([]string{}, func(v string) string { return v })
+	if empty == nil || len(empty) != 0 {
+		t.Error(empty)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	testData := []string{"a", "bb", "cc", "ddd"}
+
+	rs := slice.Associate(testData, func(v string) (int, string) {
+		return len(v), v
+	})
+	if len(rs) != 3 || rs[1] != "a" || rs[2] != "cc" || rs[3] != "ddd" {
+		t.Error(rs)
+	}
+
+	empty := slice.Associate([]string{}, func(v string) (string, int) {
+		return v, len(v)
+	})
+	if empty == nil || len(empty) != 0 {
+		t.Error(empty)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	testData := []user{{1, "a"}, {2, "b"}, {1, "c"}}
+
+	rs := slice.KeyBy(testData, func(v user) int {
+		return v.ID
+	})
+	if len(rs) != 2 || rs[1].Name != "c" || rs[2].Name != "b" {
+		t.Error(rs)
+	}
+}
+
+func TestChunkEach(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	var chunks [][]int
+	err := slice.ChunkEach(testData, 2, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(chunks) != 3 || !slices.Equal(chunks[2], []int{5}) {
+		t.Error(chunks)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = slice.ChunkEach(testData, 2, func(chunk []int) error {
+		calls++
+		if chunk[0] == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Error(err)
+	}
+	if calls != 2 {
+		t.Error(calls)
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	testData := make([]int, 100)
+	for i := range testData {
+		testData[i] = i
+	}
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 200} {
+		rs := slice.ParallelMap(testData, workers, func(v int) int {
+			return v * 2
+		})
+
+		if len(rs) != len(testData) {
+			t.Fatal(workers, len(rs))
+		}
+
+		for i, v := range rs {
+			if v != i*2 {
+				t.Errorf("workers %d: rs[%d] = %d", workers, i, v)
+			}
+		}
+	}
+
+	if rs := slice.ParallelMap([]int{}, 4, func(v int) int { return v }); len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	testData := make([]int, 10000)
+	fn := func(v int) int {
+		sum := 0
+		for i := 0; i < 1000; i++ {
+			sum += v * i
+		}
+		return sum
+	}
+
+	b.Run("Map", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			slice.Map(testData, func(v int, i int) int {
+				return fn(v)
+			})
+		}
+	})
+
+	b.Run("ParallelMap", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			slice.ParallelMap(testData, 4, fn)
+		}
+	})
+}
+
+func TestFirstE(t *testing.T) {
+	if _, err := slice.FirstE([]int{}); !errors.Is(err, slice.ErrEmpty) {
+		t.Error(err)
+	}
+
+	rs, err := slice.FirstE([]int{1, 2, 3})
+	if err != nil || rs != 1 {
+		t.Error(rs, err)
+	}
+}
+
+func TestLastE(t *testing.T) {
+	if _, err := slice.LastE([]int{}); !errors.Is(err, slice.ErrEmpty) {
+		t.Error(err)
+	}
+
+	rs, err := slice.LastE([]int{1, 2, 3})
+	if err != nil || rs != 3 {
+		t.Error(rs, err)
+	}
+}
+
+func TestPopE(t *testing.T) {
+	if _, _, err := slice.PopE([]int{}); !errors.Is(err, slice.ErrEmpty) {
+		t.Error(err)
+	}
+
+	out, pop, err := slice.PopE([]int{1, 2, 3})
+	if err != nil || pop != 3 || !slices.Equal(out, []int{1, 2}) {
+		t.Error(out, pop, err)
+	}
+}
+
+func TestDequeueE(t *testing.T) {
+	if _, _, err := slice.DequeueE([]int{}); !errors.Is(err, slice.ErrEmpty) {
+		t.Error(err)
+	}
+
+	out, deq, err := slice.DequeueE([]int{1, 2, 3})
+	if err != nil || deq != 1 || !slices.Equal(out, []int{2, 3}) {
+		t.Error(out, deq, err)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	rs1 := slice.Shuffle(testData, rand.New(rand.NewSource(42)))
+	rs2 := slice.Shuffle(testData, rand.New(rand.NewSource(42)))
+	if !slices.Equal(rs1, rs2) {
+		t.Error(rs1, rs2)
+	}
+
+	sorted := slices.Clone(rs1)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, testData) {
+		t.Error(rs1)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestSample(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	rs1 := slice.Sample(testData, 3, rand.New(rand.NewSource(7)))
+	rs2 := slice.Sample(testData, 3, rand.New(rand.NewSource(7)))
+	if len(rs1) != 3 || !slices.Equal(rs1, rs2) {
+		t.Error(rs1, rs2)
+	}
+
+	if len(slice.Distinct(rs1)) != 3 {
+		t.Error("sample must be distinct", rs1)
+	}
+
+	all := slice.Sample(testData, 20, rand.New(rand.NewSource(7)))
+	if len(all) != len(testData) {
+		t.Error(all)
+	}
+
+	if rs := slice.Sample(testData, -1, rand.New(rand.NewSource(7))); len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestSplice(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	rs, removed := slice.Splice(testData, 2, 0, 10, 11)
+	if !slices.Equal(rs, []int{1, 2, 10, 11, 3, 4, 5}) || len(removed) != 0 {
+		t.Error(rs, removed)
+	}
+
+	rs, removed = slice.Splice(testData, 1, 2)
+	if !slices.Equal(rs, []int{1, 4, 5}) || !slices.Equal(removed, []int{2, 3}) {
+		t.Error(rs, removed)
+	}
+
+	rs, removed = slice.Splice(testData, 3, 1, 9)
+	if !slices.Equal(rs, []int{1, 2, 3, 9, 5}) || !slices.Equal(removed, []int{4}) {
+		t.Error(rs, removed)
+	}
+
+	rs, removed = slice.Splice(testData, -3, 100)
+	if len(rs) != 0 || !slices.Equal(removed, testData) {
+		t.Error(rs, removed)
+	}
+
+	rs, removed = slice.Splice(testData, 100, -1, 6)
+	if !slices.Equal(rs, []int{1, 2, 3, 4, 5, 6}) || len(removed) != 0 {
+		t.Error(rs, removed)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	if rs := slice.InsertAt([]int{1, 4}, 1, 2, 3); !slices.Equal(rs, []int{1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.InsertAt([]int{1, 2}, 2, 3, 4); !slices.Equal(rs, []int{1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.InsertAt([]int{3, 4}, -1, 1, 2); !slices.Equal(rs, []int{1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.InsertAt([]int{1, 2}, 10, 3); !slices.Equal(rs, []int{1, 2, 3}) {
+		t.Error(rs)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5}
+
+	if rs := slice.RemoveRange(testData, 1, 3); !slices.Equal(rs, []int{1, 4, 5}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.RemoveRange(testData, -2, 2); !slices.Equal(rs, []int{3, 4, 5}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.RemoveRange(testData, 3, 100); !slices.Equal(rs, []int{1, 2, 3}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.RemoveRange(testData, 3, 1); !slices.Equal(rs, testData) {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 3, 4, 5}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestRemoveValue(t *testing.T) {
+	testData := []int{1, 2, 1, 3, 1}
+
+	if rs := slice.RemoveValue(testData, 1); !slices.Equal(rs, []int{2, 3}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.RemoveValue(testData, 9); !slices.Equal(rs, testData) {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []int{1, 2, 1, 3, 1}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestFill(t *testing.T) {
+	if rs := slice.Fill("x", 3); !slices.Equal(rs, []string{"x", "x", "x"}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Fill(1, 0); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Fill(1, -1); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	if rs := slice.Repeat([]int{1, 2}, 3); !slices.Equal(rs, []int{1, 2, 1, 2, 1, 2}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Repeat([]int{1, 2}, 0); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Repeat([]int{1, 2}, -1); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestRange(t *testing.T) {
+	if rs := slice.Range(0, 5, 1); !slices.Equal(rs, []int{0, 1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Range(1, 10, 3); !slices.Equal(rs, []int{1, 4, 7}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Range(5, 0, -2); !slices.Equal(rs, []int{5, 3, 1}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.Range(0, 5, -1); len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Range(5, 0, 1); len(rs) != 0 {
+		t.Error(rs)
+	}
+
+	if rs := slice.Range(0, 5, 0); len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestMinBy(t *testing.T) {
+	testData := []string{"ccc", "a", "bb", "d"}
+
+	v, i := slice.MinBy(testData, func(v string) int {
+		return len(v)
+	})
+	if v != "a" || i != 1 {
+		t.Error(v, i)
+	}
+
+	v, i = slice.MinBy([]string{}, func(v string) int {
+		return len(v)
+	})
+	if v != "" || i != -1 {
+		t.Error(v, i)
+	}
+}
+
+func TestMaxBy(t *testing.T) {
+	testData := []string{"a", "ccc", "bb", "ddd"}
+
+	v, i := slice.MaxBy(testData, func(v string) int {
+		return len(v)
+	})
+	if v != "ccc" || i != 1 {
+		t.Error(v, i)
+	}
+
+	v, i = slice.MaxBy([]string{}, func(v string) int {
+		return len(v)
+	})
+	if v != "" || i != -1 {
+		t.Error(v, i)
+	}
+}
+
+func TestSortCopy(t *testing.T) {
+	testData := []int{3, 1, 2}
+
+	if rs := slice.SortCopy(testData); !slices.Equal(rs, []int{1, 2, 3}) {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []int{3, 1, 2}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestSortFuncCopy(t *testing.T) {
+	testData := []string{"bb", "a", "ccc"}
+
+	rs := slice.SortFuncCopy(testData, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	if !slices.Equal(rs, []string{"ccc", "bb", "a"}) {
+		t.Error(rs)
+	}
+
+	if !slices.Equal(testData, []string{"bb", "a", "ccc"}) {
+		t.Error("input mutated", testData)
+	}
+}
+
+func TestForEachChunk(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5, 6, 7}
+
+	var indexes []int
+	err := slice.ForEachChunk(testData, 3, func(chunkIndex int, chunk []int) error {
+		indexes = append(indexes, chunkIndex)
+		if chunk[0] != testData[chunkIndex*3] {
+			t.Error(chunkIndex, chunk)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if !slices.Equal(indexes, []int{0, 1, 2}) {
+		t.Error(indexes)
+	}
+
+	stop := errors.New("stop")
+	indexes = nil
+	err = slice.ForEachChunk(testData, 2, func(chunkIndex int, chunk []int) error {
+		indexes = append(indexes, chunkIndex)
+		if chunkIndex == 1 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Error(err)
+	}
+	if !slices.Equal(indexes, []int{0, 1}) {
+		t.Error(indexes)
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	if !slice.EqualUnordered([]int{1, 2, 2}, []int{2, 1, 2}) {
+		t.Error("must be equal")
+	}
+
+	if slice.EqualUnordered([]int{1, 2, 2}, []int{1, 2}) {
+		t.Error("must not be equal")
+	}
+
+	if slice.EqualUnordered([]int{1, 1, 2}, []int{1, 2, 2}) {
+		t.Error("must not be equal")
+	}
+
+	if !slice.EqualUnordered([]int{}, nil) {
+		t.Error("empty slices must be equal")
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	rs := slice.Frequency([]string{"a", "b", "a", "c", "a"})
+	if len(rs) != 3 || rs["a"] != 3 || rs["b"] != 1 || rs["c"] != 1 {
+		t.Error(rs)
+	}
+
+	rs = slice.Frequency([]string{"a", "b"})
+	if len(rs) != 2 || rs["a"] != 1 || rs["b"] != 1 {
+		t.Error(rs)
+	}
+
+	rs = slice.Frequency([]string{})
+	if rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestMode(t *testing.T) {
+	v, count, ok := slice.Mode([]int{1, 2, 2, 3, 2})
+	if !ok || v != 2 || count != 3 {
+		t.Error(v, count, ok)
+	}
+
+	v, count, ok = slice.Mode([]int{3, 1, 1, 3, 2})
+	if !ok || v != 3 || count != 2 {
+		t.Error(v, count, ok)
+	}
+
+	v, count, ok = slice.Mode([]int{})
+	if ok || v != 0 || count != 0 {
+		t.Error(v, count, ok)
+	}
+}
+
+func TestEvery(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	if !slice.Every([]int{}, positive) {
+		t.Error("every must be true for an empty slice")
+	}
+
+	if !slice.Every([]int{1, 2, 3}, positive) {
+		t.Error("all elements match")
+	}
+
+	calls := 0
+	rs := slice.Every([]int{1, -2, 3}, func(v int) bool {
+		calls++
+		return positive(v)
+	})
+	if rs || calls != 2 {
+		t.Error(rs, calls)
+	}
+
+	if slice.Every([]int{-1, -2}, positive) {
+		t.Error("no element matches")
+	}
+}
+
+func TestSome(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	if slice.Some([]int{}, positive) {
+		t.Error("some must be false for an empty slice")
+	}
+
+	if !slice.Some([]int{1, 2, 3}, positive) {
+		t.Error("all elements match")
+	}
+
+	calls := 0
+	rs := slice.Some([]int{-1, 2, 3}, func(v int) bool {
+		calls++
+		return positive(v)
+	})
+	if !rs || calls != 2 {
+		t.Error(rs, calls)
+	}
+
+	if slice.Some([]int{-1, -2}, positive) {
+		t.Error("no element matches")
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	testData := []string{"apple", "banana"}
+
+	if !slice.ContainsFunc(testData, func(v string) bool { return v == "banana" }) {
+		t.Error("banana must be contained")
+	}
+
+	if slice.ContainsFunc(testData, func(v string) bool { return v == "cherry" }) {
+		t.Error("cherry must not be contained")
+	}
+
+	if slice.ContainsFunc([]string{}, func(v string) bool { return true }) {
+		t.Error("empty slice contains nothing")
+	}
+}
+
+func TestDeepCopyFunc(t *testing.T) {
+	testData := [][]int{{1, 2}, {3}}
+
+	rs := slice.DeepCopyFunc(testData, slices.Clone[[]int])
+	rs[0][0] = 100
+
+	if testData[0][0] != 1 {
+		t.Error("original mutated", testData)
+	}
+
+	if len(rs) != 2 || !slices.Equal(rs[1], []int{3}) {
+		t.Error(rs)
+	}
+}
+
+func TestDedupInPlace(t *testing.T) {
+	testData := []int{1, 2, 1, 3, 2, 4}
+
+	rs := slice.DedupInPlace(testData)
+	if !slices.Equal(rs, []int{1, 2, 3, 4}) {
+		t.Error(rs)
+	}
+
+	if &rs[0] != &testData[0] {
+		t.Error("dedup must reuse the backing array")
+	}
+
+	if rs := slice.DedupInPlace([]int{}); len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func BenchmarkDedupInPlace(b *testing.B) {
+	source := make([]int, 10000)
+	for i := range source {
+		source[i] = i % 100
+	}
+
+	b.Run("Distinct", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			slice.Distinct(source)
+		}
+	})
+
+	b.Run("DedupInPlace", func(b *testing.B) {
+		testData := make([]int, len(source))
+		for n := 0; n < b.N; n++ {
+			copy(testData, source)
+			slice.DedupInPlace(testData)
+		}
+	})
+}
+
+func TestChunkBy(t *testing.T) {
+	testData := []int{1, 1, 2, 2, 2, 3, 1}
+
+	rs := slice.ChunkBy(testData, func(prev, curr int) bool {
+		return prev != curr
+	})
+	expected := [][]int{{1, 1}, {2, 2, 2}, {3}, {1}}
+	if len(rs) != len(expected) {
+		t.Fatal(rs)
+	}
+	for i, chunk := range rs {
+		if !slices.Equal(chunk, expected[i]) {
+			t.Error(i, chunk)
+		}
+	}
+
+	rs = slice.ChunkBy(testData, func(prev, curr int) bool {
+		return false
+	})
+	if len(rs) != 1 || !slices.Equal(rs[0], testData) {
+		t.Error(rs)
+	}
+
+	if rs = slice.ChunkBy([]int{}, func(prev, curr int) bool { return true }); len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestIndexAll(t *testing.T) {
+	testData := []int{1, 2, 1, 3, 1}
+
+	if rs := slice.IndexAll(testData, 1); !slices.Equal(rs, []int{0, 2, 4}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.IndexAll(testData, 5); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestIndexAllFunc(t *testing.T) {
+	testData := []int{1, 2, 3, 4, 5, 6}
+
+	if rs := slice.IndexAllFunc(testData, func(v int) bool { return v%2 == 0 }); !slices.Equal(rs, []int{1, 3, 5}) {
+		t.Error(rs)
+	}
+
+	if rs := slice.IndexAllFunc(testData, func(v int) bool { return v > 10 }); rs == nil || len(rs) != 0 {
+		t.Error(rs)
+	}
+}
+
+func TestTap(t *testing.T) {
+	testData := []int{1, 2, 3}
+
+	calls := 0
+	rs := slice.Tap(testData, func(s []int) {
+		calls++
+		if !slices.Equal(s, testData) {
+			t.Error(s)
+		}
+	})
+
+	if calls != 1 {
+		t.Error(calls)
+	}
+
+	if len(rs) != len(testData) || &rs[0] != &testData[0] {
+		t.Error("tap must return the same slice")
+	}
+}
+
+func TestSliceE(t *testing.T) {
+	testData := []int{1, 2, 3}
+
+	for _, bounds := range [][2]int{{-1, 2}, {0, 4}, {2, 1}} {
+		if _, err := slice.SliceE(testData, bounds[0], bounds[1]); !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Error(bounds, err)
+		}
+	}
+
+	rs, err := slice.SliceE(testData, 1, 3)
+	if err != nil || !slices.Equal(rs, []int{2, 3}) {
+		t.Error(rs, err)
+	}
+}
+
+func TestRemoveE(t *testing.T) {
+	for _, index := range []int{-1, 3} {
+		if _, err := slice.RemoveE([]int{1, 2, 3}, index); !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Error(index, err)
+		}
+	}
+
+	rs, err := slice.RemoveE([]int{1, 2, 3}, 1)
+	if err != nil || !slices.Equal(rs, []int{1, 3}) {
+		t.Error(rs, err)
+	}
+}
+
+func TestReplaceE(t *testing.T) {
+	for _, index := range []int{-1, 3} {
+		if _, err := slice.ReplaceE([]int{1, 2, 3}, index, 9); !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Error(index, err)
+		}
+	}
+
+	rs, err := slice.ReplaceE([]int{1, 2, 3}, 1, 9)
+	if err != nil || !slices.Equal(rs, []int{1, 9, 3}) {
+		t.Error(rs, err)
+	}
+}
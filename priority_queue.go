@@ -0,0 +1,94 @@
+package gollection
+
+// PriorityQueue is a binary heap ordered by a less function.
+//
+// The element for which less reports true against every other element is popped first.
+type PriorityQueue[T interface{}] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates an empty PriorityQueue ordered by less.
+func NewPriorityQueue[T interface{}](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		items: make([]T, 0),
+		less:  less,
+	}
+}
+
+// Push adds an item to the queue in O(log n).
+func (pq *PriorityQueue[T]) Push(item T) {
+	pq.items = append(pq.items, item)
+	pq.up(len(pq.items) - 1)
+}
+
+// Pop removes and returns the highest priority item in O(log n).
+//
+// It returns ErrIsEmpty if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq.Len() == 0 {
+		var zero T
+		return zero, ErrIsEmpty
+	}
+
+	last := len(pq.items) - 1
+	top := pq.items[0]
+	pq.items[0] = pq.items[last]
+
+	var zero T
+	pq.items[last] = zero
+	pq.items = pq.items[:last]
+	pq.down(0)
+
+	return top, nil
+}
+
+// Peek returns the highest priority item without removing it.
+//
+// It returns ErrIsEmpty if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.Len() == 0 {
+		var zero T
+		return zero, ErrIsEmpty
+	}
+
+	return pq.items[0], nil
+}
+
+// Len returns the number of items in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.items[i], pq.items[parent]) {
+			break
+		}
+
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && pq.less(pq.items[left], pq.items[smallest]) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.items[right], pq.items[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+}
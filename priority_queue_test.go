@@ -0,0 +1,65 @@
+package gollection_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/meteormin/gollection"
+)
+
+func TestPriorityQueue_Pop(t *testing.T) {
+	pq := gollection.NewPriorityQueue(func(a, b int) bool {
+		return a < b
+	})
+
+	for _, v := range []int{5, 3, 8, 1, 9, 2, 7, 3} {
+		pq.Push(v)
+	}
+
+	if pq.Len() != 8 {
+		t.Error(pq.Len())
+	}
+
+	expected := []int{1, 2, 3, 3, 5, 7, 8, 9}
+	for _, want := range expected {
+		got, err := pq.Pop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	}
+
+	if _, err := pq.Pop(); !errors.Is(err, gollection.ErrIsEmpty) {
+		t.Error(err)
+	}
+}
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	type task struct {
+		Name     string
+		Priority int
+	}
+
+	pq := gollection.NewPriorityQueue(func(a, b task) bool {
+		return a.Priority > b.Priority
+	})
+
+	if _, err := pq.Peek(); !errors.Is(err, gollection.ErrIsEmpty) {
+		t.Error(err)
+	}
+
+	pq.Push(task{"low", 1})
+	pq.Push(task{"high", 10})
+	pq.Push(task{"mid", 5})
+
+	peek, err := pq.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if peek.Name != "high" || pq.Len() != 3 {
+		t.Error(peek, pq.Len())
+	}
+}
@@ -0,0 +1,99 @@
+package gollection
+
+// Set is an unordered collection of unique elements backed by a map.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates a Set containing the given items.
+//
+// Duplicate items are stored once.
+func NewSet[T comparable](items ...T) *Set[T] {
+	set := &Set[T]{
+		items: make(map[T]struct{}, len(items)),
+	}
+
+	for _, item := range items {
+		set.Add(item)
+	}
+
+	return set
+}
+
+// Add adds an item to the set.
+//
+// Adding an item that is already present has no effect.
+func (s *Set[T]) Add(item T) {
+	s.items[item] = struct{}{}
+}
+
+// Remove removes an item from the set.
+//
+// Removing an item that is not present has no effect.
+func (s *Set[T]) Remove(item T) {
+	delete(s.items, item)
+}
+
+// Contains reports whether the item is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Union returns a new set with the items present in either s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := NewSet[T]()
+
+	for item := range s.items {
+		union.Add(item)
+	}
+
+	for item := range other.items {
+		union.Add(item)
+	}
+
+	return union
+}
+
+// Intersect returns a new set with the items present in both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	intersect := NewSet[T]()
+
+	for item := range s.items {
+		if other.Contains(item) {
+			intersect.Add(item)
+		}
+	}
+
+	return intersect
+}
+
+// Difference returns a new set with the items of s that are not present in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	difference := NewSet[T]()
+
+	for item := range s.items {
+		if !other.Contains(item) {
+			difference.Add(item)
+		}
+	}
+
+	return difference
+}
+
+// ToSlice returns the items of the set as a slice.
+//
+// The order of the returned items is unspecified.
+func (s *Set[T]) ToSlice() []T {
+	items := make([]T, 0, len(s.items))
+	for item := range s.items {
+		items = append(items, item)
+	}
+
+	return items
+}
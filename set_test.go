@@ -0,0 +1,85 @@
+package gollection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/meteormin/gollection"
+)
+
+func sortedSet(s *gollection.Set[int]) []int {
+	items := s.ToSlice()
+	slices.Sort(items)
+	return items
+}
+
+func TestNewSet(t *testing.T) {
+	set := gollection.NewSet(1, 2, 2, 3)
+
+	if set.Len() != 3 {
+		t.Error(set.Len())
+	}
+
+	if !slices.Equal(sortedSet(set), []int{1, 2, 3}) {
+		t.Error(set.ToSlice())
+	}
+}
+
+func TestSet_Add(t *testing.T) {
+	set := gollection.NewSet[int]()
+	set.Add(1)
+	set.Add(1)
+
+	if set.Len() != 1 || !set.Contains(1) {
+		t.Error(set.ToSlice())
+	}
+}
+
+func TestSet_Remove(t *testing.T) {
+	set := gollection.NewSet(1, 2)
+	set.Remove(1)
+	set.Remove(3)
+
+	if set.Len() != 1 || set.Contains(1) || !set.Contains(2) {
+		t.Error(set.ToSlice())
+	}
+}
+
+func TestSet_Union(t *testing.T) {
+	a := gollection.NewSet(1, 2)
+	b := gollection.NewSet(2, 3)
+
+	if union := a.Union(b); !slices.Equal(sortedSet(union), []int{1, 2, 3}) {
+		t.Error(union.ToSlice())
+	}
+
+	if a.Len() != 2 || b.Len() != 2 {
+		t.Error("operands mutated")
+	}
+}
+
+func TestSet_Intersect(t *testing.T) {
+	a := gollection.NewSet(1, 2, 3)
+	b := gollection.NewSet(2, 3, 4)
+
+	if intersect := a.Intersect(b); !slices.Equal(sortedSet(intersect), []int{2, 3}) {
+		t.Error(intersect.ToSlice())
+	}
+
+	if a.Len() != 3 || b.Len() != 3 {
+		t.Error("operands mutated")
+	}
+}
+
+func TestSet_Difference(t *testing.T) {
+	a := gollection.NewSet(1, 2, 3)
+	b := gollection.NewSet(2, 4)
+
+	if difference := a.Difference(b); !slices.Equal(sortedSet(difference), []int{1, 3}) {
+		t.Error(difference.ToSlice())
+	}
+
+	if a.Len() != 3 || b.Len() != 2 {
+		t.Error("operands mutated")
+	}
+}